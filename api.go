@@ -0,0 +1,146 @@
+package untappd
+
+import (
+	"context"
+	"iter"
+	"net/http"
+)
+
+// AuthAPI is the set of Untappd APIv4 methods which require authentication,
+// implemented by AuthService.
+type AuthAPI interface {
+	// https://untappd.com/api/docs#checkin
+	Checkin(r CheckinRequest) (*Checkin, *http.Response, error)
+	CheckinContext(ctx context.Context, r CheckinRequest) (*Checkin, *http.Response, error)
+
+	// https://untappd.com/api/docs#activityfeed
+	AllCheckins(ctx context.Context) iter.Seq2[*Checkin, error]
+	Checkins() ([]*Checkin, *http.Response, error)
+	CheckinsContext(ctx context.Context) ([]*Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimit(minID int, maxID int, limit int) ([]*Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimitContext(ctx context.Context, minID int, maxID int, limit int) ([]*Checkin, *http.Response, error)
+}
+
+// BeerAPI is the set of Untappd APIv4 methods involving a Beer, implemented
+// by BeerService.
+type BeerAPI interface {
+	// https://untappd.com/api/docs#beeractivityfeed
+	AllCheckins(ctx context.Context, id int) iter.Seq2[*Checkin, error]
+	Checkins(id int) ([]*Checkin, *http.Response, error)
+	CheckinsContext(ctx context.Context, id int) ([]*Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimit(id int, minID int, maxID int, limit int) ([]*Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimitContext(ctx context.Context, id int, minID int, maxID int, limit int) ([]*Checkin, *http.Response, error)
+
+	// https://untappd.com/api/docs#beerinfo
+	Info(id int, compact bool) (*Beer, *http.Response, error)
+	InfoContext(ctx context.Context, id int, compact bool) (*Beer, *http.Response, error)
+	InfoMany(ctx context.Context, ids []int, compact bool) ([]*Beer, error)
+
+	// https://untappd.com/api/docs#beersearch
+	AllSearch(ctx context.Context, query string, sort Sort) iter.Seq2[*Beer, error]
+	Search(query string) ([]*Beer, *http.Response, error)
+	SearchContext(ctx context.Context, query string) ([]*Beer, *http.Response, error)
+	SearchOffsetLimitSort(query string, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error)
+	SearchOffsetLimitSortContext(ctx context.Context, query string, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error)
+}
+
+// BreweryAPI is the set of Untappd APIv4 methods involving a Brewery,
+// implemented by BreweryService.
+type BreweryAPI interface {
+	// /v4/brewery/beer_list, not listed in the API documentation
+	AllBeers(ctx context.Context, id int, sort Sort) iter.Seq2[*Beer, error]
+	Beers(id int) ([]*Beer, *http.Response, error)
+	BeersContext(ctx context.Context, id int) ([]*Beer, *http.Response, error)
+	BeersOffsetLimitSort(id int, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error)
+	BeersOffsetLimitSortContext(ctx context.Context, id int, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error)
+
+	// https://untappd.com/api/docs#breweryactivityfeed
+	AllCheckins(ctx context.Context, id int) iter.Seq2[*Checkin, error]
+	Checkins(id int) ([]*Checkin, *http.Response, error)
+	CheckinsContext(ctx context.Context, id int) ([]*Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimit(id int, minID int, maxID int, limit int) ([]*Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimitContext(ctx context.Context, id int, minID int, maxID int, limit int) ([]*Checkin, *http.Response, error)
+
+	// https://untappd.com/api/docs#breweryinfo
+	Info(id int, compact bool) (*Brewery, *http.Response, error)
+	InfoContext(ctx context.Context, id int, compact bool) (*Brewery, *http.Response, error)
+	InfoMany(ctx context.Context, ids []int, compact bool) ([]*Brewery, error)
+
+	// https://untappd.com/api/docs#brewerysearch
+	AllSearch(ctx context.Context, query string) iter.Seq2[*Brewery, error]
+	Search(query string) ([]*Brewery, *http.Response, error)
+	SearchContext(ctx context.Context, query string) ([]*Brewery, *http.Response, error)
+	SearchOffsetLimit(query string, offset int, limit int) ([]*Brewery, *http.Response, error)
+	SearchOffsetLimitContext(ctx context.Context, query string, offset int, limit int) ([]*Brewery, *http.Response, error)
+}
+
+// LocalAPI is the set of Untappd APIv4 methods involving a local area,
+// implemented by LocalService.
+type LocalAPI interface {
+	// https://untappd.com/api/docs#theppublocal
+	AllCheckins(ctx context.Context, r LocalCheckinsRequest) iter.Seq2[*Checkin, error]
+	Checkins(latitude float64, longitude float64) ([]*Checkin, *http.Response, error)
+	CheckinsContext(ctx context.Context, latitude float64, longitude float64) ([]*Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimitRadius(r LocalCheckinsRequest) ([]*Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimitRadiusContext(ctx context.Context, r LocalCheckinsRequest) ([]*Checkin, *http.Response, error)
+}
+
+// UserAPI is the set of Untappd APIv4 methods involving a User, implemented
+// by UserService.
+type UserAPI interface {
+	// https://untappd.com/api/docs#userbadges
+	AllBadges(ctx context.Context, username string) iter.Seq2[*Badge, error]
+	Badges(username string) ([]*Badge, *http.Response, error)
+	BadgesContext(ctx context.Context, username string) ([]*Badge, *http.Response, error)
+	BadgesOffsetLimit(username string, offset int, limit int) ([]*Badge, *http.Response, error)
+	BadgesOffsetLimitContext(ctx context.Context, username string, offset int, limit int) ([]*Badge, *http.Response, error)
+
+	// https://untappd.com/api/docs#userbeers
+	AllBeers(ctx context.Context, username string, sort Sort) iter.Seq2[*Beer, error]
+	Beers(username string) ([]*Beer, *http.Response, error)
+	BeersContext(ctx context.Context, username string) ([]*Beer, *http.Response, error)
+	BeersOffsetLimitSort(username string, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error)
+	BeersOffsetLimitSortContext(ctx context.Context, username string, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error)
+
+	// https://untappd.com/api/docs#useractivityfeed
+	AllCheckins(ctx context.Context, username string) iter.Seq2[*Checkin, error]
+	Checkins(username string) ([]*Checkin, *http.Response, error)
+	CheckinsContext(ctx context.Context, username string) ([]*Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimit(username string, minID int, maxID int, limit int) ([]*Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimitContext(ctx context.Context, username string, minID int, maxID int, limit int) ([]*Checkin, *http.Response, error)
+
+	// https://untappd.com/api/docs#userfriends
+	AllFriends(ctx context.Context, username string) iter.Seq2[*User, error]
+	Friends(username string) ([]*User, *http.Response, error)
+	FriendsContext(ctx context.Context, username string) ([]*User, *http.Response, error)
+	FriendsOffsetLimit(username string, offset int, limit int) ([]*User, *http.Response, error)
+	FriendsOffsetLimitContext(ctx context.Context, username string, offset int, limit int) ([]*User, *http.Response, error)
+
+	// https://untappd.com/api/docs#userinfo
+	Info(username string, compact bool) (*User, *http.Response, error)
+	InfoContext(ctx context.Context, username string, compact bool) (*User, *http.Response, error)
+	InfoMany(ctx context.Context, usernames []string, compact bool) ([]*User, error)
+
+	// https://untappd.com/api/docs#userwishlist
+	AllWishList(ctx context.Context, username string, sort Sort) iter.Seq2[*Beer, error]
+	WishList(username string) ([]*Beer, *http.Response, error)
+	WishListContext(ctx context.Context, username string) ([]*Beer, *http.Response, error)
+	WishListOffsetLimitSort(username string, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error)
+	WishListOffsetLimitSortContext(ctx context.Context, username string, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error)
+}
+
+// VenueAPI is the set of Untappd APIv4 methods involving a Venue,
+// implemented by VenueService.
+type VenueAPI interface {
+	// https://untappd.com/api/docs#venueactivityfeed
+	AllCheckins(ctx context.Context, id int) iter.Seq2[*Checkin, error]
+	Checkins(id int) ([]*Checkin, *http.Response, error)
+	CheckinsContext(ctx context.Context, id int) ([]*Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimit(id int, minID int, maxID int, limit int) ([]*Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimitContext(ctx context.Context, id int, minID int, maxID int, limit int) ([]*Checkin, *http.Response, error)
+
+	// https://untappd.com/api/docs#venueinfo
+	Info(id int, compact bool) (*Venue, *http.Response, error)
+	InfoContext(ctx context.Context, id int, compact bool) (*Venue, *http.Response, error)
+	InfoMany(ctx context.Context, ids []int, compact bool) ([]*Venue, error)
+}
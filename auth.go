@@ -2,6 +2,7 @@ package untappd
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -9,16 +10,29 @@ import (
 )
 
 const (
-	// untappdOAuthAuthenticate is a URL pattern which is interpolated with
-	// an Untappd APIv4 client ID and redirect URL.  The resulting URL can
-	// be returned to clients to begin the authentication process.
-	untappdOAuthAuthenticate = "https://untappd.com/oauth/authenticate/?client_id=%s&response_type=code&redirect_url=%s"
-
-	// untappdOAuthAuthorize is a URL pattern which is interpolated with
-	// an Untappd APIv4 client ID, client secret, and redirect URL.
-	// The resulting URL is requested by AuthHandler.ServeHTTP, generating
-	// an Access Token for client consumption.
-	untappdOAuthAuthorize = "https://untappd.com/oauth/authorize/?client_id=%s&client_secret=%s&response_type=code&redirect_url=%s"
+	// untappdOAuthAuthenticate is the URL of the Untappd OAuth authenticate
+	// endpoint.  With an Untappd APIv4 client ID and redirect URL added as
+	// query parameters, it can be returned to clients to begin the
+	// authentication process.
+	untappdOAuthAuthenticate = "https://untappd.com/oauth/authenticate/"
+
+	// untappdOAuthAuthorize is the URL of the Untappd OAuth authorize
+	// endpoint.  With an Untappd APIv4 client ID, client secret, redirect URL,
+	// and code added as query parameters, it is requested by
+	// AuthHandler.ServeHTTP, generating an Access Token for client
+	// consumption.
+	untappdOAuthAuthorize = "https://untappd.com/oauth/authorize/"
+)
+
+var (
+	// ErrInvalidState is returned by an AuthHandler when the OAuth state
+	// parameter in a request does not match the one generated when the user
+	// began authentication.
+	ErrInvalidState = errors.New("invalid or expired 'state' GET parameter; please begin authentication again")
+
+	// ErrNoCode is returned by an AuthHandler when a request contains an
+	// OAuth state parameter, but no code parameter.
+	ErrNoCode = errors.New("no 'code' GET parameter")
 )
 
 // AuthService is a "service" which allows access to API methods which require
@@ -30,9 +44,21 @@ type AuthService struct {
 // AuthHandler implements http.Handler, and provides a simple process for
 // authenticating users using OAuth with Untappd APIv4.
 type AuthHandler struct {
+	// StateStore stores the OAuth state value generated for each user who
+	// begins authentication, so it can be verified when Untappd redirects
+	// the user back to the AuthHandler.  If not set, a CookieStateStore
+	// is used.
+	StateStore StateStore
+
+	// ErrorHandler is invoked when authentication fails, so that a custom
+	// failure page may be rendered.  If not set, the error is written to
+	// the HTTP response as plain text, using the AuthError's status code.
+	ErrorHandler ErrorHandlerFunc
+
 	clientID     string
 	clientSecret string
 	redirectURL  *url.URL
+	authURL      *url.URL
 	oAuthURL     *url.URL
 	handler      TokenHandlerFunc
 	client       *http.Client
@@ -44,8 +70,43 @@ type AuthHandler struct {
 // available for further HTTP processing.
 type TokenHandlerFunc func(token string, w http.ResponseWriter, r *http.Request)
 
+// An AuthError is an error which occurred while an AuthHandler authenticated a
+// user.
+type AuthError struct {
+	// The HTTP status code which is appropriate for the error.
+	Status int
+
+	// The underlying error.  If the user denied access, or the Untappd
+	// OAuth authorize endpoint returned an error, Err is an *Error.  If the
+	// user's OAuth state did not match, Err is ErrInvalidState.
+	Err error
+}
+
+// Error implements error.
+func (e *AuthError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *AuthError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorHandlerFunc is a function which is invoked when an AuthHandler fails to
+// authenticate a user.  The error which occurred is provided via the err
+// parameter, and the HTTP request and response writers are available to
+// render a response.
+type ErrorHandlerFunc func(err *AuthError, w http.ResponseWriter, r *http.Request)
+
+// defaultErrorFn is the default implementation of ErrorHandlerFunc, and is used
+// automatically by AuthHandler, unless a custom ErrorHandlerFunc is set.  This
+// function simply writes the error to the HTTP response writer.
+var defaultErrorFn = func(err *AuthError, w http.ResponseWriter, r *http.Request) {
+	http.Error(w, err.Error(), err.Status)
+}
+
 // defaultTokenFn is the default implementation of TokenHandlerFunc, and is used
-// automatically by NewAuthHandler, unless a custom TokenHandlerFunc is provided.
+// automatically by NewOAuthHandler, unless a custom TokenHandlerFunc is provided.
 // This function simply prints the token to the HTTP response writer.
 var defaultTokenFn = func(token string, w http.ResponseWriter, r *http.Request) {
 	if _, err := w.Write([]byte(token)); err != nil {
@@ -53,16 +114,40 @@ var defaultTokenFn = func(token string, w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// NewAuthHandler creates a http.Handler which can be used to easily authenticate
+// NewAuthHandler creates an AuthHandler, as described for NewOAuthHandler.
+//
+// The first return parameter is the AuthHandler.  The second is the URL which
+// should be provided to a user, so that they can begin the authentication
+// flow.  This is redirectURL itself, since users must begin authentication at
+// the AuthHandler so that a state value can be saved for their session; the
+// Untappd authenticate URL cannot be visited directly.  The third contains any
+// errors which may have occurred during setup.
+//
+// Deprecated: use NewOAuthHandler, which does not return a URL.
+func NewAuthHandler(clientID string, clientSecret string, redirectURL string, fn TokenHandlerFunc, client *http.Client) (*AuthHandler, *url.URL, error) {
+	h, err := NewOAuthHandler(clientID, clientSecret, redirectURL, fn, client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u := *h.redirectURL
+	return h, &u, nil
+}
+
+// NewOAuthHandler creates a http.Handler which can be used to easily authenticate
 // a user using the Server Side Authentication process, documented here:
 // https://untappd.com/api/docs#authentication.
 //
-// The first return parameter is the http.Handler described above.  The second is
-// a URL which should be provided to a user, so that they can begin the
-// authentication flow.  The third contains any errors which may have occurred
-// during setup.
+// The first return parameter is the http.Handler described above.  The second
+// contains any errors which may have occurred during setup.
 //
-// The client ID, client secret, and redirectURL parameters are mandatory.
+// The client ID, client secret, and redirectURL parameters are mandatory.  The
+// AuthHandler must be served at redirectURL: requests without an OAuth code
+// begin authentication, and are redirected to Untappd with a new state value
+// for the user's session.  Untappd then redirects the user back to
+// redirectURL, where the state value is verified before the code is used.
+// Thus, users begin the authentication flow by visiting redirectURL itself,
+// or a URL returned by AuthHandler.AuthenticateURL.
 //
 // The TokenHandlerFunc parameter can be used to provide a custom handler which
 // contains an access token, and HTTP request and response writers, for further
@@ -75,40 +160,40 @@ var defaultTokenFn = func(token string, w http.ResponseWriter, r *http.Request)
 // obeys timeouts, etc.  This client is used to communicate with an upstream
 // OAuth authentication server.  If no http.Client is provided, http.DefaultClient
 // will be used.
-func NewAuthHandler(clientID string, clientSecret string, redirectURL string, fn TokenHandlerFunc, client *http.Client) (*AuthHandler, *url.URL, error) {
+func NewOAuthHandler(clientID string, clientSecret string, redirectURL string, fn TokenHandlerFunc, client *http.Client) (*AuthHandler, error) {
 	// Disallow empty ID and secret
 	if clientID == "" {
-		return nil, nil, ErrNoClientID
+		return nil, ErrNoClientID
 	}
 	if clientSecret == "" {
-		return nil, nil, ErrNoClientSecret
+		return nil, ErrNoClientSecret
 	}
 
 	// Validate user redirect URL
 	ru, err := url.Parse(redirectURL)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	// Validate client authentication URL
-	cu, err := url.Parse(fmt.Sprintf(
-		untappdOAuthAuthenticate,
-		clientID,
-		ru.String(),
-	))
+	// Build client authentication URL
+	cu, err := oauthURL(untappdOAuthAuthenticate, url.Values{
+		"client_id":     []string{clientID},
+		"response_type": []string{"code"},
+		"redirect_url":  []string{ru.String()},
+	})
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	// Validate OAuth URL
-	ou, err := url.Parse(fmt.Sprintf(
-		untappdOAuthAuthorize,
-		clientID,
-		clientSecret,
-		ru.String(),
-	))
+	// Build OAuth URL, to which a code is added for each request
+	ou, err := oauthURL(untappdOAuthAuthorize, url.Values{
+		"client_id":     []string{clientID},
+		"client_secret": []string{clientSecret},
+		"response_type": []string{"code"},
+		"redirect_url":  []string{ru.String()},
+	})
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	// If no token handler is set, use default
@@ -125,10 +210,57 @@ func NewAuthHandler(clientID string, clientSecret string, redirectURL string, fn
 		clientID:     clientID,
 		clientSecret: clientSecret,
 		redirectURL:  ru,
+		authURL:      cu,
 		oAuthURL:     ou,
 		handler:      fn,
 		client:       client,
-	}, cu, nil
+	}, nil
+}
+
+// oauthURL parses the Untappd OAuth endpoint URL rawurl, and adds the query
+// parameters in q.
+func oauthURL(rawurl string, q url.Values) (*url.URL, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+
+	u.RawQuery = q.Encode()
+	return u, nil
+}
+
+// AuthenticateURL generates a new OAuth state value for the session of the
+// user making request r, saves it using the AuthHandler's StateStore, and
+// returns a URL containing it, which the user can visit to authenticate with
+// Untappd.  It is used by ServeHTTP to begin authentication, but may also be
+// used to link to Untappd directly.
+func (a *AuthHandler) AuthenticateURL(w http.ResponseWriter, r *http.Request) (*url.URL, error) {
+	state, err := newState()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.stateStore().Save(w, r, state); err != nil {
+		return nil, err
+	}
+
+	u := *a.authURL
+	q := u.Query()
+	q.Set("state", state)
+	u.RawQuery = q.Encode()
+
+	return &u, nil
+}
+
+// stateStore returns the AuthHandler's StateStore, or a CookieStateStore if
+// none is set.  The default CookieStateStore only sends its cookie over HTTPS
+// if the redirect URL uses HTTPS.
+func (a *AuthHandler) stateStore() StateStore {
+	if a.StateStore == nil {
+		return &CookieStateStore{Secure: a.redirectURL.Scheme == "https"}
+	}
+
+	return a.StateStore
 }
 
 // ServeHTTP implements http.Handler, and provides a simple http.Handler which
@@ -137,35 +269,87 @@ func NewAuthHandler(clientID string, clientSecret string, redirectURL string, fn
 func (a *AuthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Verify correct HTTP method
 	if r.Method != "GET" {
-		http.Error(w, "only GET requests are allowed", http.StatusMethodNotAllowed)
+		a.writeError(w, r, http.StatusMethodNotAllowed, errors.New("only GET requests are allowed"))
+		return
+	}
+
+	// If neither code, state, nor error parameters are present, the user
+	// is beginning authentication, so redirect them to Untappd
+	q := r.URL.Query()
+	code, state, e := q.Get("code"), q.Get("state"), q.Get("error")
+	if code == "" && state == "" && e == "" {
+		u, err := a.AuthenticateURL(w, r)
+		if err != nil {
+			a.writeError(w, r, http.StatusInternalServerError, err)
+			return
+		}
+
+		http.Redirect(w, r, u.String(), http.StatusFound)
+		return
+	}
+
+	// Verify the state parameter matches the one generated when this user
+	// began authentication, so that a code obtained by another user or site
+	// is never used.  The state is consumed even if Untappd reports an
+	// error, so that it cannot be used again.
+	ok, err := a.stateStore().Verify(w, r, state)
+	if err != nil {
+		a.writeError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	if !ok {
+		a.writeError(w, r, http.StatusForbidden, ErrInvalidState)
+		return
+	}
+
+	// If the user denied access, or authentication failed, Untappd reports
+	// the error instead of a code
+	if e != "" {
+		a.writeError(w, r, http.StatusForbidden, &Error{
+			Type:   e,
+			Detail: q.Get("error_description"),
+		})
 		return
 	}
 
 	// Verify non-empty code parameter
-	code := r.URL.Query().Get("code")
 	if code == "" {
-		http.Error(w, "no 'code' GET parameter", http.StatusBadRequest)
+		a.writeError(w, r, http.StatusBadRequest, ErrNoCode)
 		return
 	}
 
 	// Perform HTTP GET request to retrieve token using the
 	// code provided from query parameter
-	res, err := a.client.Get(a.oAuthURL.String() + "&code=" + code)
+	u := *a.oAuthURL
+	oq := u.Query()
+	oq.Set("code", code)
+	u.RawQuery = oq.Encode()
+
+	res, err := a.client.Get(u.String())
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		// The error contains the request URL, so the client secret must
+		// be redacted
+		a.writeError(w, r, http.StatusInternalServerError, redactError(err))
 		return
 	}
 	defer res.Body.Close()
 
-	// Verify authentication server did not return an error
-	if c := res.StatusCode; c > 299 || c < 200 {
-		http.Error(w, fmt.Sprintf("authentication server error: HTTP %03d", c), http.StatusBadGateway)
+	// Verify authentication server returned JSON
+	c := res.StatusCode
+	if !strings.Contains(res.Header.Get("Content-Type"), jsonContentType) {
+		if c > 299 || c < 200 {
+			a.writeError(w, r, http.StatusBadGateway, fmt.Errorf("authentication server error: HTTP %03d", c))
+			return
+		}
+
+		a.writeError(w, r, http.StatusBadGateway, errors.New("authentication server sent non-JSON content"))
 		return
 	}
 
-	// Verify authentication server returned JSON
-	if !strings.Contains(res.Header.Get("Content-Type"), jsonContentType) {
-		http.Error(w, "authentication server sent non-JSON content", http.StatusBadGateway)
+	// Verify authentication server did not return an error, decoding it
+	// if so
+	if err := checkResponse(res); err != nil {
+		a.writeError(w, r, http.StatusBadGateway, err)
 		return
 	}
 
@@ -178,7 +362,7 @@ func (a *AuthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	// Decode JSON body to retrieve token
 	if err := json.NewDecoder(res.Body).Decode(&v); err != nil {
-		http.Error(w, err.Error(), http.StatusBadGateway)
+		a.writeError(w, r, http.StatusBadGateway, err)
 		return
 	}
 
@@ -186,3 +370,14 @@ func (a *AuthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// so the client can do whatever they please with it
 	a.handler(v.Response.AccessToken, w, r)
 }
+
+// writeError invokes the AuthHandler's ErrorHandler, or the default one if none is
+// set, with an AuthError containing status and err.
+func (a *AuthHandler) writeError(w http.ResponseWriter, r *http.Request, status int, err error) {
+	fn := a.ErrorHandler
+	if fn == nil {
+		fn = defaultErrorFn
+	}
+
+	fn(&AuthError{Status: status, Err: err}, w, r)
+}
@@ -1,6 +1,7 @@
 package untappd
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -20,6 +21,10 @@ import (
 //       GMTOffset: offset,
 //       TimeZone:  timezone,
 //   }
+//
+// Only the parameters documented for the checkin/add endpoint are sent.  The
+// serving style, purchase venue, and flavor profile tags reported for a Checkin
+// cannot be set.
 type CheckinRequest struct {
 	// Mandatory parameters
 	BeerID    int
@@ -48,6 +53,18 @@ type CheckinRequest struct {
 // A variety of struct members can be filled in to specify the rating,
 // comment, etc. for a checkin.
 func (a *AuthService) Checkin(r CheckinRequest) (*Checkin, *http.Response, error) {
+	return a.CheckinContext(context.Background(), r)
+}
+
+// CheckinContext is like Checkin, but uses ctx for the request.
+func (a *AuthService) CheckinContext(ctx context.Context, r CheckinRequest) (*Checkin, *http.Response, error) {
+	return traceCall(ctx, a.client, "untappd.Auth.Checkin", func(ctx context.Context) (*Checkin, *http.Response, error) {
+		return a.checkin(ctx, r)
+	})
+}
+
+// checkin implements CheckinContext.
+func (a *AuthService) checkin(ctx context.Context, r CheckinRequest) (*Checkin, *http.Response, error) {
 	// Add required parameters
 	q := url.Values{
 		"bid":        []string{strconv.Itoa(r.BeerID)},
@@ -89,7 +106,7 @@ func (a *AuthService) Checkin(r CheckinRequest) (*Checkin, *http.Response, error
 	}
 
 	// Perform request to check in a beer
-	res, err := a.client.request("POST", "checkin/add", q, nil, &v)
+	res, err := a.client.requestContext(ctx, "POST", "checkin/add", q, nil, &v)
 	if err != nil {
 		return nil, res, err
 	}
@@ -3,6 +3,7 @@ package untappd
 import (
 	"net/http"
 	"net/url"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
@@ -73,6 +74,37 @@ func TestClientAuthCheckinOK(t *testing.T) {
 	}
 }
 
+// TestClientAuthCheckinServingStylePurchaseVenueOK verifies that the serving
+// style, purchase venue, and flavors reported by the API for a checkin made
+// by Client.Auth.Checkin are present on the resulting Checkin.
+func TestClientAuthCheckinServingStylePurchaseVenueOK(t *testing.T) {
+	purchaseVenueID := 9917
+
+	c, done := authCheckinTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"response":{"checkin_id":1,"serving_type":"Can","flavor_profile":["Hoppy","Citrusy"],"purchase_venue":{"venue_id":9917,"venue_name":"Bierkraft"}}}`))
+	})
+	defer done()
+
+	checkin, _, err := c.Auth.Checkin(CheckinRequest{
+		BeerID: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s := checkin.ServingStyle; s != ServingCan {
+		t.Fatalf("unexpected ServingStyle: %q != %q", s, ServingCan)
+	}
+	if id := checkin.PurchaseVenue.ID; id != purchaseVenueID {
+		t.Fatalf("unexpected PurchaseVenue.ID: %d != %d", id, purchaseVenueID)
+	}
+
+	flavors := []FlavorTag{FlavorHoppy, FlavorCitrusy}
+	if !reflect.DeepEqual(checkin.Flavors, flavors) {
+		t.Fatalf("unexpected Flavors: %v != %v", checkin.Flavors, flavors)
+	}
+}
+
 // TestClientAuthCheckinBadBeerID verifies that Client.Auth.Checkin returns an
 // error when an invalid beer ID is checked-in.
 func TestClientAuthCheckinBadBeerID(t *testing.T) {
@@ -1,6 +1,8 @@
 package untappd
 
 import (
+	"context"
+	"iter"
 	"math"
 	"net/http"
 	"net/url"
@@ -15,9 +17,14 @@ import (
 // checkins.  For more granular control, and to page through the checkins
 // list using ID parameters, use CheckinsMinMaxIDLimit instead.
 func (a *AuthService) Checkins() ([]*Checkin, *http.Response, error) {
+	return a.CheckinsContext(context.Background())
+}
+
+// CheckinsContext is like Checkins, but uses ctx for the request.
+func (a *AuthService) CheckinsContext(ctx context.Context) ([]*Checkin, *http.Response, error) {
 	// Use default parameters as specified by API.  Max ID is somewhat
 	// arbitrary, but should provide plenty of headroom, just in case.
-	return a.CheckinsMinMaxIDLimit(0, math.MaxInt32, 25)
+	return a.CheckinsMinMaxIDLimitContext(ctx, 0, math.MaxInt32, 25)
 }
 
 // CheckinsMinMaxIDLimit queries for information about checkins from friends
@@ -29,9 +36,36 @@ func (a *AuthService) Checkins() ([]*Checkin, *http.Response, error) {
 // 50 checkins is the maximum number of checkins which may be returned by
 // one call.
 func (a *AuthService) CheckinsMinMaxIDLimit(minID int, maxID int, limit int) ([]*Checkin, *http.Response, error) {
-	return a.client.getCheckins("checkin/recent", url.Values{
+	return a.CheckinsMinMaxIDLimitContext(context.Background(), minID, maxID, limit)
+}
+
+// CheckinsMinMaxIDLimitContext is like CheckinsMinMaxIDLimit, but uses ctx for
+// the request.
+func (a *AuthService) CheckinsMinMaxIDLimitContext(ctx context.Context, minID int, maxID int, limit int) ([]*Checkin, *http.Response, error) {
+	return traceCall(ctx, a.client, "untappd.Auth.Checkins", func(ctx context.Context) ([]*Checkin, *http.Response, error) {
+		return a.checkinsMinMaxIDLimit(ctx, minID, maxID, limit)
+	})
+}
+
+// checkinsMinMaxIDLimit implements CheckinsMinMaxIDLimitContext.
+func (a *AuthService) checkinsMinMaxIDLimit(ctx context.Context, minID int, maxID int, limit int) ([]*Checkin, *http.Response, error) {
+	return a.client.getCheckins(ctx, "checkin/recent", url.Values{
 		"min_id": []string{strconv.Itoa(minID)},
 		"max_id": []string{strconv.Itoa(maxID)},
 		"limit":  []string{strconv.Itoa(limit)},
 	})
 }
+
+// AllCheckins returns an iterator over all checkins from friends of the
+// authenticated user, newest first, paging through the checkins list using
+// CheckinsMinMaxIDLimit.
+//
+// One API call is made for every 50 checkins, and no further calls are made
+// once iteration stops.  Iteration ends after yielding a request error, or if
+// ctx is canceled.
+func (a *AuthService) AllCheckins(ctx context.Context) iter.Seq2[*Checkin, error] {
+	return checkinPages(ctx, a.client, "untappd.Auth.AllCheckins", math.MaxInt32, 50, func(ctx context.Context, maxID int, limit int) ([]*Checkin, error) {
+		checkins, _, err := a.CheckinsMinMaxIDLimitContext(ctx, 0, maxID, limit)
+		return checkins, err
+	})
+}
@@ -0,0 +1,105 @@
+package untappd
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"time"
+)
+
+const (
+	// defaultStateCookie is the name of the cookie used by CookieStateStore,
+	// if no name is set.
+	defaultStateCookie = "untappd_oauth_state"
+
+	// stateCookieMaxAge is the amount of time for which a user may complete
+	// authentication after a state value is saved by CookieStateStore.
+	stateCookieMaxAge = 10 * time.Minute
+
+	// stateBytes is the number of random bytes in each OAuth state value.
+	stateBytes = 32
+)
+
+// A StateStore stores the OAuth state values generated by an AuthHandler, so
+// that each authentication callback can be verified to belong to the session
+// of the user who began authentication.  This protects against cross-site
+// request forgery and login fixation.
+type StateStore interface {
+	// Save associates state with the session of the user making request r.
+	Save(w http.ResponseWriter, r *http.Request, state string) error
+
+	// Verify reports whether state was saved for the session of the user
+	// making request r.  Each state value must only be verified once.
+	Verify(w http.ResponseWriter, r *http.Request, state string) (bool, error)
+}
+
+// CookieStateStore is a StateStore which stores state values in a cookie on
+// the user's browser.  The zero value is ready to use.
+type CookieStateStore struct {
+	// The name of the cookie.  If not set, "untappd_oauth_state" is used.
+	Name string
+
+	// Secure, if enabled, ensures the cookie is only sent over HTTPS.  The
+	// cookie is always secure if it is set on a request received over TLS,
+	// but a TLS-terminating proxy in front of the AuthHandler hides this,
+	// so Secure should be enabled whenever the redirect URL uses HTTPS.
+	Secure bool
+}
+
+// Save implements StateStore.
+func (s *CookieStateStore) Save(w http.ResponseWriter, r *http.Request, state string) error {
+	http.SetCookie(w, s.cookie(r, state, int(stateCookieMaxAge.Seconds())))
+	return nil
+}
+
+// Verify implements StateStore.
+func (s *CookieStateStore) Verify(w http.ResponseWriter, r *http.Request, state string) (bool, error) {
+	c, err := r.Cookie(s.name())
+	if err != nil {
+		return false, nil
+	}
+
+	// Always clear the cookie, so that its state cannot be reused
+	http.SetCookie(w, s.cookie(r, "", -1))
+
+	if state == "" || c.Value == "" {
+		return false, nil
+	}
+
+	return subtle.ConstantTimeCompare([]byte(state), []byte(c.Value)) == 1, nil
+}
+
+// cookie creates a cookie containing state, which expires after maxAge
+// seconds.  The cookie is sent on the top-level redirect back from Untappd,
+// but not on cross-site subrequests.
+func (s *CookieStateStore) cookie(r *http.Request, state string, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     s.name(),
+		Value:    state,
+		Path:     "/",
+		MaxAge:   maxAge,
+		Secure:   s.Secure || r.TLS != nil,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+// name returns the name of the cookie used by s.
+func (s *CookieStateStore) name() string {
+	if s.Name == "" {
+		return defaultStateCookie
+	}
+
+	return s.Name
+}
+
+// newState generates a new, random OAuth state value.
+func newState() (string, error) {
+	b := make([]byte, stateBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
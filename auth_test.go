@@ -2,17 +2,19 @@ package untappd
 
 import (
 	"errors"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"reflect"
+	"strings"
 	"testing"
 )
 
-// TestNewAuthHandler verifies that NewAuthHandler returns appropriate errors
+// TestNewOAuthHandler verifies that NewOAuthHandler returns appropriate errors
 // for various types of input parameters.
-func TestNewAuthHandler(t *testing.T) {
+func TestNewOAuthHandler(t *testing.T) {
 	const badURL = "http://%20.com"
 
 	var tests = []struct {
@@ -55,7 +57,7 @@ func TestNewAuthHandler(t *testing.T) {
 	}
 
 	for _, tt := range tests {
-		if _, _, err := NewAuthHandler(tt.clientID, tt.clientSecret, tt.redirectURL, nil, nil); err != tt.err {
+		if _, err := NewOAuthHandler(tt.clientID, tt.clientSecret, tt.redirectURL, nil, nil); err != tt.err {
 			// Special case: check for matching type *url.Error
 			if reflect.TypeOf(err) == reflect.TypeOf(tt.err) {
 				continue
@@ -66,6 +68,31 @@ func TestNewAuthHandler(t *testing.T) {
 	}
 }
 
+// TestNewAuthHandlerURL verifies that NewAuthHandler returns the redirect URL
+// as the URL at which users begin authentication.
+func TestNewAuthHandlerURL(t *testing.T) {
+	const redirectURL = "http://foo.com/callback"
+
+	h, u, err := NewAuthHandler("foo", "bar", redirectURL, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := u.String(); got != redirectURL {
+		t.Fatalf("unexpected URL: %q != %q", got, redirectURL)
+	}
+
+	// Modifying the returned URL must not affect the AuthHandler
+	u.Host = "bar.com"
+	if got := h.redirectURL.String(); got != redirectURL {
+		t.Fatalf("unexpected redirect URL: %q != %q", got, redirectURL)
+	}
+
+	if _, _, err := NewAuthHandler("", "bar", redirectURL, nil, nil); err != ErrNoClientID {
+		t.Fatalf("unexpected error: %v != %v", err, ErrNoClientID)
+	}
+}
+
 // TestAuthHandlerServeHTTPBadMethod verifies that AuthHandler returns a
 // HTTP 405 on non-GET method.
 func TestAuthHandlerServeHTTPBadMethod(t *testing.T) {
@@ -88,7 +115,7 @@ func TestAuthHandlerServeHTTPNoCodeParameter(t *testing.T) {
 	url, done := testAuthHandler(t, "http://foo.com", "", nil)
 	defer done()
 
-	res, err := http.Get(url)
+	res, err := http.Get(url + "?state=" + testState)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -124,6 +151,323 @@ func TestAuthHandlerServeHTTPOAuthBadJSON(t *testing.T) {
 	})
 }
 
+// TestAuthHandlerServeHTTPOAuthUnreachable verifies that AuthHandler returns
+// a HTTP 500 if the upstream server is unreachable, without exposing the
+// client secret in the error.
+func TestAuthHandlerServeHTTPOAuthUnreachable(t *testing.T) {
+	oauthHost, done := testOAuthServer(t, nil)
+	done()
+
+	url, done2 := testAuthHandler(t, "http://foo.com", oauthHost, nil)
+	defer done2()
+
+	res, err := http.Get(url + "?code=foo&state=" + testState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	if got, want := res.StatusCode, http.StatusInternalServerError; got != want {
+		t.Fatalf("unexpected HTTP status code: %d != %d", got, want)
+	}
+
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body := string(b); strings.Contains(body, "client_secret=bar") || !strings.Contains(body, "client_secret=REDACTED") {
+		t.Fatalf("unexpected error body: %s", body)
+	}
+}
+
+// TestAuthHandlerServeHTTPBeginAuthentication verifies that AuthHandler
+// redirects a user to Untappd with a new state value, saved in a cookie, if
+// no code or state parameters are passed via query string.
+func TestAuthHandlerServeHTTPBeginAuthentication(t *testing.T) {
+	h, err := NewOAuthHandler("foo", "bar", "http://foo.com/callback", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	states := make(map[string]bool)
+	for i := 0; i < 2; i++ {
+		state, cookie := testBeginAuthentication(t, h)
+		if state != cookie.Value {
+			t.Fatalf("unexpected state cookie value: %q != %q", cookie.Value, state)
+		}
+		if !cookie.HttpOnly || cookie.SameSite != http.SameSiteLaxMode {
+			t.Fatalf("unexpected state cookie attributes: %v", cookie)
+		}
+
+		states[state] = true
+	}
+
+	if len(states) != 2 {
+		t.Fatal("state values were reused between sessions")
+	}
+}
+
+// TestAuthHandlerServeHTTPSecureCookie verifies that the default state cookie
+// is only sent over HTTPS when the redirect URL uses HTTPS, or when
+// CookieStateStore.Secure is enabled.
+func TestAuthHandlerServeHTTPSecureCookie(t *testing.T) {
+	var tests = []struct {
+		description string
+		redirectURL string
+		store       StateStore
+		secure      bool
+	}{
+		{
+			description: "HTTP redirect URL",
+			redirectURL: "http://foo.com/callback",
+		},
+		{
+			description: "HTTPS redirect URL",
+			redirectURL: "https://foo.com/callback",
+			secure:      true,
+		},
+		{
+			description: "secure CookieStateStore",
+			redirectURL: "http://foo.com/callback",
+			store:       &CookieStateStore{Secure: true},
+			secure:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		h, err := NewOAuthHandler("foo", "bar", tt.redirectURL, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h.StateStore = tt.store
+
+		_, cookie := testBeginAuthentication(t, h)
+		if got, want := cookie.Secure, tt.secure; got != want {
+			t.Fatalf("unexpected Secure for test %q: %v != %v", tt.description, got, want)
+		}
+	}
+}
+
+// TestAuthHandlerServeHTTPErrorConsumesState verifies that AuthHandler
+// verifies and clears the user's state before reporting an error returned by
+// Untappd, so that the state cannot be used again.
+func TestAuthHandlerServeHTTPErrorConsumesState(t *testing.T) {
+	h, err := NewOAuthHandler("foo", "bar", "http://foo.com/callback", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var authErr *AuthError
+	h.ErrorHandler = func(err *AuthError, w http.ResponseWriter, r *http.Request) {
+		authErr = err
+		w.WriteHeader(err.Status)
+	}
+
+	state, cookie := testBeginAuthentication(t, h)
+
+	r := httptest.NewRequest("GET", "http://foo.com/callback?error=access_denied&state="+state, nil)
+	r.AddCookie(cookie)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if _, ok := authErr.Err.(*Error); !ok {
+		t.Fatalf("unexpected error: %v", authErr.Err)
+	}
+
+	cookies := w.Result().Cookies()
+	if len(cookies) != 1 || cookies[0].Name != cookie.Name || cookies[0].MaxAge >= 0 {
+		t.Fatalf("state cookie was not cleared: %v", cookies)
+	}
+}
+
+// TestAuthHandlerServeHTTPBadState verifies that AuthHandler returns a HTTP
+// 403 if the state parameter does not match the user's session, and that each
+// state value may only be used once.
+func TestAuthHandlerServeHTTPBadState(t *testing.T) {
+	oauthHost, done := testOAuthServer(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"response":{"access_token":"ABCDEF"}}`))
+	})
+	defer done()
+
+	h, err := NewOAuthHandler("foo", "bar", "http://foo.com/callback", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.oAuthURL.Scheme = "http"
+	h.oAuthURL.Host = oauthHost
+
+	state, cookie := testBeginAuthentication(t, h)
+
+	var tests = []struct {
+		description string
+		state       string
+		cookie      *http.Cookie
+		code        int
+	}{
+		{
+			description: "no cookie",
+			state:       state,
+			code:        http.StatusForbidden,
+		},
+		{
+			description: "mismatched state",
+			state:       "foo",
+			cookie:      cookie,
+			code:        http.StatusForbidden,
+		},
+		{
+			description: "no state",
+			cookie:      cookie,
+			code:        http.StatusForbidden,
+		},
+		{
+			description: "ok",
+			state:       state,
+			cookie:      cookie,
+			code:        http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		r := httptest.NewRequest("GET", "http://foo.com/callback?code=foo&state="+tt.state, nil)
+		if tt.cookie != nil {
+			r.AddCookie(tt.cookie)
+		}
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		if got, want := w.Code, tt.code; got != want {
+			t.Fatalf("unexpected HTTP status code for test %q: %d != %d", tt.description, got, want)
+		}
+	}
+}
+
+// TestAuthHandlerEscapesParameters verifies that AuthHandler escapes the
+// redirect URL and code parameters in requests to Untappd.
+func TestAuthHandlerEscapesParameters(t *testing.T) {
+	const (
+		redirectURL = "http://foo.com/callback?a=1&b=2"
+		code        = "foo&bar=baz qux"
+	)
+
+	oauthHost, done := testOAuthServer(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if got := q.Get("code"); got != code {
+			t.Fatalf("unexpected code: %q != %q", got, code)
+		}
+		if got := q.Get("redirect_url"); got != redirectURL {
+			t.Fatalf("unexpected redirect URL: %q != %q", got, redirectURL)
+		}
+
+		w.Write([]byte(`{"response":{"access_token":"ABCDEF"}}`))
+	})
+	defer done()
+
+	handlerURL, done2 := testAuthHandler(t, redirectURL, oauthHost, nil)
+	defer done2()
+
+	if got, want := testAuthHandlerAuthURL(t, handlerURL).Query().Get("redirect_url"), redirectURL; got != want {
+		t.Fatalf("unexpected authentication redirect URL: %q != %q", got, want)
+	}
+
+	res, err := http.Get(handlerURL + "?state=" + testState + "&code=" + url.QueryEscape(code))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := res.StatusCode, http.StatusOK; got != want {
+		t.Fatalf("unexpected HTTP status code: %d != %d", got, want)
+	}
+}
+
+// TestAuthHandlerServeHTTPErrors verifies that AuthHandler invokes its
+// ErrorHandler with an appropriate AuthError for OAuth errors.
+func TestAuthHandlerServeHTTPErrors(t *testing.T) {
+	oauthHost, done := testOAuthServer(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"meta":{"code":500,"error_detail":"The code is invalid or has expired.","error_type":"invalid_param","response_time":{"time":0,"measure":"seconds"}}}`))
+	})
+	defer done()
+
+	h, err := NewOAuthHandler("foo", "bar", "http://foo.com/callback", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.oAuthURL.Scheme = "http"
+	h.oAuthURL.Host = oauthHost
+	h.StateStore = testStateStore{}
+
+	var authErr *AuthError
+	h.ErrorHandler = func(err *AuthError, w http.ResponseWriter, r *http.Request) {
+		authErr = err
+		w.WriteHeader(http.StatusTeapot)
+	}
+
+	var tests = []struct {
+		description string
+		query       string
+		status      int
+		err         error
+	}{
+		{
+			description: "access denied",
+			query:       "?error=access_denied&error_description=The+user+denied+access.&state=" + testState,
+			status:      http.StatusForbidden,
+			err: &Error{
+				Type:   "access_denied",
+				Detail: "The user denied access.",
+			},
+		},
+		{
+			description: "access denied, invalid state",
+			query:       "?error=access_denied&state=bar",
+			status:      http.StatusForbidden,
+			err:         ErrInvalidState,
+		},
+		{
+			description: "invalid state",
+			query:       "?code=foo&state=bar",
+			status:      http.StatusForbidden,
+			err:         ErrInvalidState,
+		},
+		{
+			description: "no code",
+			query:       "?state=" + testState,
+			status:      http.StatusBadRequest,
+			err:         ErrNoCode,
+		},
+		{
+			description: "authorize error",
+			query:       "?code=foo&state=" + testState,
+			status:      http.StatusBadGateway,
+			err: &Error{
+				Code:   500,
+				Type:   "invalid_param",
+				Detail: "The code is invalid or has expired.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		authErr = nil
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest("GET", "http://foo.com/callback"+tt.query, nil))
+
+		if w.Code != http.StatusTeapot || authErr == nil {
+			t.Fatalf("ErrorHandler not invoked for test %q", tt.description)
+		}
+		if got, want := authErr.Status, tt.status; got != want {
+			t.Fatalf("unexpected status for test %q: %d != %d", tt.description, got, want)
+		}
+		if !reflect.DeepEqual(authErr.Err, tt.err) {
+			t.Fatalf("unexpected error for test %q:\n- want: %#v\n-  got: %#v", tt.description, tt.err, authErr.Err)
+		}
+	}
+}
+
 // TestAuthHandlerServeHTTPOK verifies that AuthHandler can complete an
 // entire mock authentication cycle, and return the correct final token upon
 // successful authentication.
@@ -144,7 +488,7 @@ func TestAuthHandlerServeHTTPOK(t *testing.T) {
 	url, done2 := testAuthHandler(t, "http://foo.com", oauthHost, tokenFn)
 	defer done2()
 
-	res, err := http.Get(url + "?code=foo")
+	res, err := http.Get(url + "?code=foo&state=" + testState)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -200,10 +544,24 @@ func Test_defaultTokenFnOK(t *testing.T) {
 	}
 }
 
+// testState is the only state value accepted by testStateStore.
+const testState = "test-state"
+
+// testStateStore is a StateStore which accepts only testState.
+type testStateStore struct{}
+
+func (testStateStore) Save(w http.ResponseWriter, r *http.Request, state string) error {
+	return nil
+}
+
+func (testStateStore) Verify(w http.ResponseWriter, r *http.Request, state string) (bool, error) {
+	return state == testState, nil
+}
+
 // testAuthHandler creates a mocked AuthHandler which points at a httptest server,
 // and returns that server's URL and a function to shut it down.
 func testAuthHandler(t *testing.T, redirectURL string, oauthHost string, fn TokenHandlerFunc) (string, func()) {
-	h, _, err := NewAuthHandler(
+	h, err := NewOAuthHandler(
 		"foo",
 		"bar",
 		redirectURL,
@@ -216,6 +574,7 @@ func testAuthHandler(t *testing.T, redirectURL string, oauthHost string, fn Toke
 
 	h.oAuthURL.Scheme = "http"
 	h.oAuthURL.Host = oauthHost
+	h.StateStore = testStateStore{}
 
 	srv := httptest.NewServer(h)
 	return srv.URL, func() {
@@ -223,6 +582,61 @@ func testAuthHandler(t *testing.T, redirectURL string, oauthHost string, fn Toke
 	}
 }
 
+// testBeginAuthentication begins authentication using h, and returns the
+// state value sent to Untappd, and the cookie in which it was saved.
+func testBeginAuthentication(t *testing.T, h *AuthHandler) (string, *http.Cookie) {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "http://foo.com/callback", nil))
+
+	if got, want := w.Code, http.StatusFound; got != want {
+		t.Fatalf("unexpected HTTP status code: %d != %d", got, want)
+	}
+
+	loc, err := url.Parse(w.Header().Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := loc.Host, "untappd.com"; got != want {
+		t.Fatalf("unexpected redirect host: %q != %q", got, want)
+	}
+
+	state := loc.Query().Get("state")
+	if state == "" {
+		t.Fatal("no state parameter in authentication URL")
+	}
+
+	cookies := w.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("unexpected number of cookies: %d != %d", len(cookies), 1)
+	}
+
+	return state, cookies[0]
+}
+
+// testAuthHandlerAuthURL begins authentication using the AuthHandler served
+// at handlerURL, and returns the Untappd authentication URL to which it
+// redirects.
+func testAuthHandlerAuthURL(t *testing.T, handlerURL string) *url.URL {
+	c := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	res, err := c.Get(handlerURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	u, err := url.Parse(res.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return u
+}
+
 // testAuthHandler creates a httptest server which mocks an upstream OAuth server,
 // and which invokes an input closure, returning that server's host and a function
 // to shut it down.
@@ -254,7 +668,7 @@ func testOAuthBadGateway(t *testing.T, fn func(t *testing.T, w http.ResponseWrit
 	url, done2 := testAuthHandler(t, "http://foo.com", oauthHost, nil)
 	defer done2()
 
-	res, err := http.Get(url + "?code=foo")
+	res, err := http.Get(url + "?code=foo&state=" + testState)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -25,6 +25,10 @@ type Badge struct {
 	// If applicable, badge levels which the specified user has obtained.
 	// If the slice has zero length, no levels exist for this badge.
 	Levels []*Badge
+
+	// The number of badge levels reported by the API, which may differ
+	// from the length of Levels.
+	LevelCount int
 }
 
 // BadgeMedia contains links to media regarding a Badge.  Included are links
@@ -64,11 +68,16 @@ func (r *rawBadge) export() *Badge {
 	}
 
 	// Export badge levels as a slice of badges belonging to parent badge
-	levels := make([]*Badge, r.Levels.Count)
-	for i := range r.Levels.Items {
-		levels[i] = r.Levels.Items[i].export()
+	levels := make([]*Badge, 0, len(r.Levels.Items))
+	for _, item := range r.Levels.Items {
+		if item == nil {
+			continue
+		}
+
+		levels = append(levels, item.export())
 	}
 	b.Levels = levels
+	b.LevelCount = r.Levels.Count
 
 	return b
 }
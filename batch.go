@@ -0,0 +1,159 @@
+package untappd
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// defaultBatchConcurrency is the number of concurrent requests made by batch
+// methods, such as BeerService.InfoMany, if Client.BatchConcurrency is not set.
+const defaultBatchConcurrency = 4
+
+// ErrRateLimitExhausted is returned for each item in a batch which was not
+// requested, because the Untappd APIv4 reported that no more requests remain
+// in the Client's rate limit.
+var ErrRateLimitExhausted = errors.New("rate limit exhausted")
+
+// batch invokes fetch for each key in keys, using a bounded number of
+// concurrent workers.  The returned slice contains the result for each key,
+// in the same order as keys.  If any calls to fetch fail, batch returns
+// ItemErrors describing each failure, indexed by position in keys, and the
+// result for each failed key is the zero value.
+//
+// No further calls to fetch are made once ctx is canceled, or once the
+// Client's rate limit is exhausted.  A request is reserved from the rate limit
+// reported by the API before each call, so concurrent calls cannot exceed it.
+//
+// The batch is traced by a span named name, which is the parent of the span
+// for each call to fetch.
+func batch[K any, T any](ctx context.Context, c *Client, name string, keys []K, fetch func(ctx context.Context, key K) (T, error)) (results []T, err error) {
+	ctx, span := c.startSpan(ctx, name)
+	defer func() {
+		endSpan(span, nil, err)
+	}()
+
+	n := c.BatchConcurrency
+	if n <= 0 {
+		n = defaultBatchConcurrency
+	}
+	if n > len(keys) {
+		n = len(keys)
+	}
+
+	results = make([]T, len(keys))
+
+	var mu sync.Mutex
+	var errs ItemErrors
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer wg.Done()
+
+			for i := range indices {
+				var v T
+				err := ctx.Err()
+				if err == nil {
+					v, err = reserveFetch(ctx, c, keys[i], fetch)
+				}
+
+				if err != nil {
+					mu.Lock()
+					errs = append(errs, &ItemError{Index: i, Err: err})
+					mu.Unlock()
+					continue
+				}
+
+				results[i] = v
+			}
+		}()
+	}
+
+	for i := range keys {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	if len(errs) == 0 {
+		return results, nil
+	}
+
+	sort.Slice(errs, func(i int, j int) bool {
+		return errs[i].Index < errs[j].Index
+	})
+
+	return results, errs
+}
+
+// reserveFetch invokes fetch for key, if a request can be reserved from the
+// Client's remaining rate limit.
+func reserveFetch[K any, T any](ctx context.Context, c *Client, key K, fetch func(ctx context.Context, key K) (T, error)) (T, error) {
+	release, ok := c.rateLimit.reserve()
+	if !ok {
+		var zero T
+		return zero, ErrRateLimitExhausted
+	}
+	defer release()
+
+	return fetch(ctx, key)
+}
+
+// rateLimitWindow is the period after which the Untappd APIv4 rate limit
+// is reset.
+const rateLimitWindow = time.Hour
+
+// rateLimitState tracks the remaining rate limit reported by the Untappd APIv4,
+// and the number of requests reserved from it which are still in flight.
+type rateLimitState struct {
+	mu        sync.Mutex
+	known     bool
+	remaining int
+	inflight  int
+	updated   time.Time
+}
+
+// update records the remaining rate limit reported in res, if any.
+func (s *rateLimitState) update(res *http.Response) {
+	n, err := strconv.Atoi(res.Header.Get(rateLimitRemainingHeader))
+	if err != nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.known = true
+	s.remaining = n
+	s.updated = time.Now()
+}
+
+// reserve reserves one request from the remaining rate limit, so that
+// concurrent requests cannot exceed it.  reserve returns false if no requests
+// remain within the current rate limit window, once in-flight requests are
+// accounted for.  Otherwise, release must be called once the request is
+// complete.  Until the API has reported a rate limit, every request may be
+// reserved.
+func (s *rateLimitState) reserve() (release func(), ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.known && time.Since(s.updated) < rateLimitWindow && s.remaining-s.inflight <= 0 {
+		return nil, false
+	}
+
+	s.inflight++
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.inflight--
+	}, true
+}
@@ -0,0 +1,203 @@
+package untappd
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestClientBeerInfoManyOK verifies that Client.Beer.InfoMany returns beers in
+// the same order as the input IDs, and does not exceed the configured
+// concurrency.
+func TestClientBeerInfoManyOK(t *testing.T) {
+	var active, peak int32
+	c, done := beerInfoTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&active, 1)
+		defer atomic.AddInt32(&active, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+
+		w.Write([]byte(`{"response":{"beer":{"bid":` + path.Base(path.Clean(r.URL.Path)) + `}}}`))
+	})
+	defer done()
+
+	c.BatchConcurrency = 2
+
+	ids := []int{5, 3, 8, 1, 9, 2, 7}
+	beers, err := c.Beer.InfoMany(context.Background(), ids, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if l := len(beers); l != len(ids) {
+		t.Fatalf("unexpected number of beers: %d != %d", l, len(ids))
+	}
+	for i := range ids {
+		if beers[i].ID != ids[i] {
+			t.Fatalf("unexpected beer ID at index %d: %d != %d", i, beers[i].ID, ids[i])
+		}
+	}
+
+	if p := atomic.LoadInt32(&peak); p > 2 {
+		t.Fatalf("unexpected number of concurrent requests: %d > %d", p, 2)
+	}
+}
+
+// TestClientBeerInfoManyBadBeer verifies that Client.Beer.InfoMany returns
+// an ItemError for an invalid beer, without failing the remaining beers.
+func TestClientBeerInfoManyBadBeer(t *testing.T) {
+	c, done := beerInfoTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		id := path.Base(path.Clean(r.URL.Path))
+		if id == "-1" {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write(invalidBeerErrJSON)
+			return
+		}
+
+		w.Write([]byte(`{"response":{"beer":{"bid":` + id + `}}}`))
+	})
+	defer done()
+
+	beers, err := c.Beer.InfoMany(context.Background(), []int{1, -1, 2}, false)
+	errs, ok := err.(ItemErrors)
+	if !ok {
+		t.Fatalf("unexpected error type: %T", err)
+	}
+
+	if l := len(errs); l != 1 {
+		t.Fatalf("unexpected number of errors: %d != %d", l, 1)
+	}
+	if i := errs[0].Index; i != 1 {
+		t.Fatalf("unexpected error index: %d != %d", i, 1)
+	}
+	assertInvalidBeerErr(t, errs[0].Err)
+
+	if beers[0].ID != 1 || beers[1] != nil || beers[2].ID != 2 {
+		t.Fatalf("unexpected beers: %v", beers)
+	}
+}
+
+// TestClientBeerInfoManyRateLimitExhausted verifies that Client.Beer.InfoMany
+// stops making requests once the API reports that the rate limit is exhausted.
+func TestClientBeerInfoManyRateLimitExhausted(t *testing.T) {
+	var requests int32
+	c, done := beerInfoTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+
+		w.Header().Set(rateLimitRemainingHeader, "0")
+		w.Write([]byte(`{"response":{"beer":{"bid":1}}}`))
+	})
+	defer done()
+
+	c.BatchConcurrency = 1
+
+	beers, err := c.Beer.InfoMany(context.Background(), []int{1, 2, 3}, false)
+	errs, ok := err.(ItemErrors)
+	if !ok {
+		t.Fatalf("unexpected error type: %T", err)
+	}
+
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Fatalf("unexpected number of requests: %d != %d", n, 1)
+	}
+	if beers[0] == nil {
+		t.Fatal("expected first beer to be retrieved")
+	}
+
+	if l := len(errs); l != 2 {
+		t.Fatalf("unexpected number of errors: %d != %d", l, 2)
+	}
+	for i, e := range errs {
+		if e.Index != i+1 {
+			t.Fatalf("unexpected error index: %d != %d", e.Index, i+1)
+		}
+		if e.Err != ErrRateLimitExhausted {
+			t.Fatalf("unexpected error: %v != %v", e.Err, ErrRateLimitExhausted)
+		}
+	}
+}
+
+// TestClientBeerInfoManyRateLimitReserved verifies that Client.Beer.InfoMany
+// never makes more concurrent requests than remain in the rate limit.
+func TestClientBeerInfoManyRateLimitReserved(t *testing.T) {
+	const remaining = 3
+
+	var requests int32
+	c, done := beerInfoTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		n := remaining
+		if !strings.HasSuffix(r.URL.Path, "/0/") {
+			n -= int(atomic.AddInt32(&requests, 1))
+
+			// Keep requests in flight, so that workers run concurrently
+			time.Sleep(20 * time.Millisecond)
+		}
+
+		w.Header().Set(rateLimitRemainingHeader, strconv.Itoa(n))
+		w.Write([]byte(`{"response":{"beer":{"bid":1}}}`))
+	})
+	defer done()
+
+	// Learn the remaining rate limit before the batch begins
+	if _, _, err := c.Beer.Info(0, false); err != nil {
+		t.Fatal(err)
+	}
+
+	c.BatchConcurrency = 8
+
+	_, err := c.Beer.InfoMany(context.Background(), []int{1, 2, 3, 4, 5, 6, 7, 8}, false)
+	errs, ok := err.(ItemErrors)
+	if !ok {
+		t.Fatalf("unexpected error type: %T", err)
+	}
+
+	if n := atomic.LoadInt32(&requests); n != remaining {
+		t.Fatalf("unexpected number of requests: %d != %d", n, remaining)
+	}
+	if l := len(errs); l != 8-remaining {
+		t.Fatalf("unexpected number of errors: %d != %d", l, 8-remaining)
+	}
+	for _, e := range errs {
+		if e.Err != ErrRateLimitExhausted {
+			t.Fatalf("unexpected error: %v != %v", e.Err, ErrRateLimitExhausted)
+		}
+	}
+}
+
+// TestClientUserInfoManyCanceled verifies that Client.User.InfoMany makes no
+// requests when its context is canceled.
+func TestClientUserInfoManyCanceled(t *testing.T) {
+	c, done := userInfoTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		t.Fatal("unexpected request with canceled context")
+	})
+	defer done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	users, err := c.User.InfoMany(ctx, []string{"mdlayher", "untappd"}, false)
+	errs, ok := err.(ItemErrors)
+	if !ok {
+		t.Fatalf("unexpected error type: %T", err)
+	}
+
+	if l := len(errs); l != 2 {
+		t.Fatalf("unexpected number of errors: %d != %d", l, 2)
+	}
+	for _, e := range errs {
+		if e.Err != context.Canceled {
+			t.Fatalf("unexpected error: %v != %v", e.Err, context.Canceled)
+		}
+	}
+	if users[0] != nil || users[1] != nil {
+		t.Fatalf("unexpected users: %v", users)
+	}
+}
@@ -1,7 +1,10 @@
 package untappd
 
 import (
+	"encoding/json"
 	"net/url"
+	"sort"
+	"strconv"
 	"time"
 )
 
@@ -24,8 +27,19 @@ type Beer struct {
 	IBU         int
 	Slug        string
 	Style       string
+	StyleID     int
 	Description string
 
+	// Is this beer currently in production?
+	InProduction bool
+
+	// Is this beer a homebrew?
+	Homebrew bool
+
+	// Is this beer a vintage or variant of another beer?
+	Vintage bool
+	Variant bool
+
 	// Time when this beer was added to Untappd.
 	Created time.Time
 
@@ -58,6 +72,49 @@ type Beer struct {
 	// If available, information regarding the brewery which created
 	// this beer.
 	Brewery *Brewery
+
+	// If available, struct containing this beer's total checkins, monthly
+	// checkins, and other various totals.
+	Stats BeerStats
+
+	// If available, the number of ratings given to this beer at each rating
+	// value, ordered from lowest to highest rating.
+	RatingDistribution []RatingCount
+
+	// If available, photos from recent checkins of this beer.
+	Media []*Photo
+
+	// If available, beers which are similar to this beer.
+	Similar []*Beer
+
+	// If available, vintages and variants of this beer.
+	Vintages []*Beer
+
+	// The number of photos, similar beers, and vintages reported by the
+	// API, which may differ from the length of each list.
+	MediaCount   int
+	SimilarCount int
+	VintageCount int
+
+	// If Client.RawJSON is enabled, the original JSON representation of
+	// this beer, including any fields which are not otherwise exported.
+	Raw json.RawMessage `json:",omitempty"`
+}
+
+// BeerStats is a struct which contains various statistics regarding an Untappd
+// beer.
+type BeerStats struct {
+	TotalCount     int `json:"total_count"`
+	MonthlyCount   int `json:"monthly_count"`
+	TotalUserCount int `json:"total_user_count"`
+	UserCount      int `json:"user_count"`
+}
+
+// RatingCount is the number of ratings given to a beer at a single rating
+// value.
+type RatingCount struct {
+	Rating float64
+	Count  int
 }
 
 // rawBeer is the raw JSON representation of an Untappd beer.  Its data is
@@ -70,16 +127,50 @@ type rawBeer struct {
 	IBU           int          `json:"beer_ibu"`
 	Slug          string       `json:"beer_slug"`
 	Style         string       `json:"beer_style"`
+	StyleID       int          `json:"beer_style_id"`
 	Description   string       `json:"beer_description"`
+	InProduction  responseBool `json:"is_in_production"`
+	Homebrew      responseBool `json:"is_homebrew"`
+	Vintage       responseBool `json:"is_vintage"`
+	Variant       responseBool `json:"is_variant"`
 	Created       responseTime `json:"created_at"`
 	WishList      bool         `json:"wish_list"`
 	OverallRating float64      `json:"rating_score"`
 	OverallCount  int          `json:"rating_count"`
+	AuthRating    float64      `json:"auth_rating"`
+	Stats         BeerStats    `json:"stats"`
+
+	// RatingDistribution maps rating values, such as "4.25", to the number
+	// of ratings given at that value.
+	RatingDistribution map[string]int `json:"rating_distribution"`
 
 	// For /v4/beer/info/ID, brewery is located inside the rawBeer struct.
 	// This is not the case with /v4/user/beers/username, where it is
 	// added by the client method.
 	Brewery *rawBrewery `json:"brewery"`
+
+	Media struct {
+		Count int         `json:"count"`
+		Items []*rawPhoto `json:"items"`
+	} `json:"media"`
+
+	Similar struct {
+		Count int `json:"count"`
+		Items []struct {
+			OverallRating float64    `json:"rating_score"`
+			Beer          rawBeer    `json:"beer"`
+			Brewery       rawBrewery `json:"brewery"`
+		} `json:"items"`
+	} `json:"similar"`
+
+	Vintages struct {
+		Count int `json:"count"`
+		Items []struct {
+			Beer rawBeer `json:"beer"`
+		} `json:"items"`
+	} `json:"vintages"`
+
+	Raw json.RawMessage `json:"-"`
 }
 
 // export creates an exported Beer from a rawBeer struct, allowing for more
@@ -93,18 +184,87 @@ func (r *rawBeer) export() *Beer {
 		IBU:           r.IBU,
 		Slug:          r.Slug,
 		Style:         r.Style,
+		StyleID:       r.StyleID,
 		Description:   r.Description,
+		InProduction:  bool(r.InProduction),
+		Homebrew:      bool(r.Homebrew),
+		Vintage:       bool(r.Vintage),
+		Variant:       bool(r.Variant),
 		Created:       time.Time(r.Created),
 		WishList:      r.WishList,
 		OverallRating: r.OverallRating,
 		OverallCount:  r.OverallCount,
+		UserRating:    r.AuthRating,
+		Stats:         r.Stats,
 	}
 
+	b.RatingDistribution = exportRatingDistribution(r.RatingDistribution)
+
 	// If brewery was present inside the Beer struct, as is the case
 	// with /v4/beer/info/ID, add it now.
 	if r.Brewery != nil {
 		b.Brewery = r.Brewery.export()
 	}
 
+	media := make([]*Photo, 0, len(r.Media.Items))
+	for _, item := range r.Media.Items {
+		if item == nil {
+			continue
+		}
+
+		media = append(media, item.export())
+	}
+	b.Media = media
+	b.MediaCount = r.Media.Count
+
+	similar := make([]*Beer, 0, len(r.Similar.Items))
+	for _, item := range r.Similar.Items {
+		beer := item.Beer.export()
+		beer.Brewery = item.Brewery.export()
+
+		// Similar beers report their rating alongside the beer, rather
+		// than inside of it
+		if beer.OverallRating == 0 {
+			beer.OverallRating = item.OverallRating
+		}
+
+		similar = append(similar, beer)
+	}
+	b.Similar = similar
+	b.SimilarCount = r.Similar.Count
+
+	vintages := make([]*Beer, 0, len(r.Vintages.Items))
+	for _, item := range r.Vintages.Items {
+		vintages = append(vintages, item.Beer.export())
+	}
+	b.Vintages = vintages
+	b.VintageCount = r.Vintages.Count
+
+	b.Raw = r.Raw
+
 	return b
 }
+
+// exportRatingDistribution creates a list of RatingCounts, ordered by rating,
+// from a map of rating values to counts.  Values which are not numbers are
+// ignored.
+func exportRatingDistribution(m map[string]int) []RatingCount {
+	counts := make([]RatingCount, 0, len(m))
+	for k, v := range m {
+		rating, err := strconv.ParseFloat(k, 64)
+		if err != nil {
+			continue
+		}
+
+		counts = append(counts, RatingCount{
+			Rating: rating,
+			Count:  v,
+		})
+	}
+
+	sort.Slice(counts, func(i, j int) bool {
+		return counts[i].Rating < counts[j].Rating
+	})
+
+	return counts
+}
@@ -1,6 +1,7 @@
 package untappd
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -10,6 +11,18 @@ import (
 // If the compact parameter is set to 'true', only basic beer information will
 // be populated.
 func (b *BeerService) Info(id int, compact bool) (*Beer, *http.Response, error) {
+	return b.InfoContext(context.Background(), id, compact)
+}
+
+// InfoContext is like Info, but uses ctx for the request.
+func (b *BeerService) InfoContext(ctx context.Context, id int, compact bool) (*Beer, *http.Response, error) {
+	return traceCall(ctx, b.client, "untappd.Beer.Info", func(ctx context.Context) (*Beer, *http.Response, error) {
+		return b.info(ctx, id, compact)
+	})
+}
+
+// info implements InfoContext.
+func (b *BeerService) info(ctx context.Context, id int, compact bool) (*Beer, *http.Response, error) {
 	// Determine if a compact response is requested
 	q := url.Values{}
 	if compact {
@@ -24,10 +37,25 @@ func (b *BeerService) Info(id int, compact bool) (*Beer, *http.Response, error)
 	}
 
 	// Perform request for beer information by ID
-	res, err := b.client.request("GET", "beer/info/"+strconv.Itoa(id), nil, q, &v)
+	res, err := b.client.requestContext(ctx, "GET", "beer/info/"+strconv.Itoa(id), nil, q, &v)
 	if err != nil {
 		return nil, res, err
 	}
 
 	return v.Response.Beer.export(), res, nil
 }
+
+// InfoMany queries for information about each Beer with the specified IDs,
+// using up to Client.BatchConcurrency concurrent requests.  The returned
+// slice contains a Beer for each of ids, in the same order.
+//
+// If information about any Beer could not be retrieved, InfoMany returns
+// ItemErrors, and the Beer at the index of each failure is nil.  Once ctx
+// is canceled or the rate limit is exhausted, ErrRateLimitExhausted or
+// the context's error is returned for each remaining Beer.
+func (b *BeerService) InfoMany(ctx context.Context, ids []int, compact bool) ([]*Beer, error) {
+	return batch(ctx, b.client, "untappd.Beer.InfoMany", ids, func(ctx context.Context, id int) (*Beer, error) {
+		beer, _, err := b.InfoContext(ctx, id, compact)
+		return beer, err
+	})
+}
@@ -3,6 +3,7 @@ package untappd
 import (
 	"net/http"
 	"net/url"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
@@ -85,6 +86,84 @@ func TestClientBeerInfoOK(t *testing.T) {
 	if c := b.OverallCount; c != overallCount {
 		t.Fatalf("unexpected OverallCount: %q != %q", c, overallCount)
 	}
+	styleID := 121
+	if id := b.StyleID; id != styleID {
+		t.Fatalf("unexpected StyleID: %d != %d", id, styleID)
+	}
+	if !b.InProduction {
+		t.Fatal("expected InProduction to be true")
+	}
+	if b.Homebrew || b.Vintage || b.Variant {
+		t.Fatal("expected Homebrew, Vintage, and Variant to be false")
+	}
+	userRating := 4.5
+	if r := b.UserRating; r != userRating {
+		t.Fatalf("unexpected UserRating: %v != %v", r, userRating)
+	}
+
+	stats := BeerStats{
+		TotalCount:     68301,
+		MonthlyCount:   512,
+		TotalUserCount: 49762,
+		UserCount:      2,
+	}
+	if s := b.Stats; s != stats {
+		t.Fatalf("unexpected Stats: %+v != %+v", s, stats)
+	}
+
+	distribution := []RatingCount{
+		{Rating: 3.75, Count: 2},
+		{Rating: 4, Count: 20},
+		{Rating: 4.5, Count: 61},
+		{Rating: 5, Count: 40},
+	}
+	if d := b.RatingDistribution; !reflect.DeepEqual(d, distribution) {
+		t.Fatalf("unexpected RatingDistribution:\n- want: %v\n-  got: %v", distribution, d)
+	}
+
+	if l := len(b.Media); l != 1 {
+		t.Fatalf("unexpected Media length: %d != %d", l, 1)
+	}
+	photoID := 24739915
+	if id := b.Media[0].ID; id != photoID {
+		t.Fatalf("unexpected Media[0].ID: %d != %d", id, photoID)
+	}
+	photoURL := "https://untappd.akamaized.net/photo/2016_01_01/black_note_raw.jpg"
+	if u := b.Media[0].Images.OriginalImage.String(); u != photoURL {
+		t.Fatalf("unexpected Media[0].Images.OriginalImage: %q != %q", u, photoURL)
+	}
+	if u := b.Media[0].User.UserName; u != "gregavola" {
+		t.Fatalf("unexpected Media[0].User.UserName: %q != %q", u, "gregavola")
+	}
+	if v := b.Media[0].Venue; v != nil {
+		t.Fatalf("unexpected Media[0].Venue: %v != nil", v)
+	}
+
+	if l := len(b.Similar); l != 1 {
+		t.Fatalf("unexpected Similar length: %d != %d", l, 1)
+	}
+	similarName := "Expedition Stout"
+	if n := b.Similar[0].Name; n != similarName {
+		t.Fatalf("unexpected Similar[0].Name: %q != %q", n, similarName)
+	}
+	if n := b.Similar[0].Brewery.Name; n != breweryName {
+		t.Fatalf("unexpected Similar[0].Brewery.Name: %q != %q", n, breweryName)
+	}
+	similarRating := 4.21
+	if r := b.Similar[0].OverallRating; r != similarRating {
+		t.Fatalf("unexpected Similar[0].OverallRating: %v != %v", r, similarRating)
+	}
+
+	if l := len(b.Vintages); l != 1 {
+		t.Fatalf("unexpected Vintages length: %d != %d", l, 1)
+	}
+	vintageName := "Black Note Stout (2015)"
+	if n := b.Vintages[0].Name; n != vintageName {
+		t.Fatalf("unexpected Vintages[0].Name: %q != %q", n, vintageName)
+	}
+	if !b.Vintages[0].Vintage {
+		t.Fatal("expected Vintages[0].Vintage to be true")
+	}
 }
 
 // beerInfoTestClient builds upon testClient, and adds additional sanity checks
@@ -125,9 +204,88 @@ var blackNoteBeerJSON = []byte(`
   "beer": {
     "bid": 1,
     "beer_name": "Black Note Stout",
+    "beer_style": "Stout - Russian Imperial",
+    "beer_style_id": 121,
+    "is_in_production": 1,
+    "is_homebrew": 0,
+    "is_vintage": 0,
+    "is_variant": 0,
     "rating_count": 123,
+    "rating_score": 4.334,
+    "auth_rating": 4.5,
+    "stats": {
+      "total_count": 68301,
+      "monthly_count": 512,
+      "total_user_count": 49762,
+      "user_count": 2
+    },
+    "rating_distribution": {
+      "5": 40,
+      "4.5": 61,
+      "4": 20,
+      "3.75": 2
+    },
     "brewery": {
       "brewery_name": "Bell's Brewery, Inc."
+    },
+    "media": {
+      "count": 1,
+      "items": [
+        {
+          "photo_id": 24739915,
+          "photo": {
+            "photo_img_sm": "https://untappd.akamaized.net/photo/2016_01_01/black_note_100x100.jpg",
+            "photo_img_md": "https://untappd.akamaized.net/photo/2016_01_01/black_note_320x320.jpg",
+            "photo_img_lg": "https://untappd.akamaized.net/photo/2016_01_01/black_note_640x640.jpg",
+            "photo_img_og": "https://untappd.akamaized.net/photo/2016_01_01/black_note_raw.jpg"
+          },
+          "created_at": "Fri, 01 Jan 2016 22:21:05 +0000",
+          "checkin_id": 133319903,
+          "user": {
+            "uid": 1,
+            "user_name": "gregavola"
+          },
+          "beer": {
+            "bid": 1,
+            "beer_name": "Black Note Stout"
+          },
+          "brewery": {
+            "brewery_id": 2507,
+            "brewery_name": "Bell's Brewery, Inc."
+          },
+          "venue": []
+        }
+      ]
+    },
+    "similar": {
+      "count": 1,
+      "items": [
+        {
+          "rating_score": 4.21,
+          "beer": {
+            "bid": 3939,
+            "beer_name": "Expedition Stout",
+            "beer_style": "Stout - Russian Imperial"
+          },
+          "brewery": {
+            "brewery_id": 2507,
+            "brewery_name": "Bell's Brewery, Inc."
+          }
+        }
+      ]
+    },
+    "vintages": {
+      "count": 1,
+      "items": [
+        {
+          "beer": {
+            "bid": 1234,
+            "beer_name": "Black Note Stout (2015)",
+            "is_vintage": 1,
+            "is_variant": 0
+          }
+        }
+      ]
     }
   }
   }
@@ -1,6 +1,9 @@
 package untappd
 
 import (
+	"context"
+	"encoding/json"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -14,8 +17,13 @@ import (
 // It is recommended to search using a "Brewery Name + Beer Name" query, such as
 // "Dogfish 60 Minute".
 func (b *BeerService) Search(query string) ([]*Beer, *http.Response, error) {
+	return b.SearchContext(context.Background(), query)
+}
+
+// SearchContext is like Search, but uses ctx for the request.
+func (b *BeerService) SearchContext(ctx context.Context, query string) ([]*Beer, *http.Response, error) {
 	// Use default parameters as specified by API
-	return b.SearchOffsetLimitSort(query, 0, 25, SortDate)
+	return b.SearchOffsetLimitSortContext(ctx, query, 0, 25, SortDate)
 }
 
 // SearchOffsetLimitSort searches for information about beers, using the specified
@@ -28,6 +36,19 @@ func (b *BeerService) Search(query string) ([]*Beer, *http.Response, error) {
 // It is recommended to search using a "Brewery Name + Beer Name" query, such as
 // "Dogfish 60 Minute".
 func (b *BeerService) SearchOffsetLimitSort(query string, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error) {
+	return b.SearchOffsetLimitSortContext(context.Background(), query, offset, limit, sort)
+}
+
+// SearchOffsetLimitSortContext is like SearchOffsetLimitSort, but uses ctx for
+// the request.
+func (b *BeerService) SearchOffsetLimitSortContext(ctx context.Context, query string, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error) {
+	return traceCall(ctx, b.client, "untappd.Beer.Search", func(ctx context.Context) ([]*Beer, *http.Response, error) {
+		return b.searchOffsetLimitSort(ctx, query, offset, limit, sort)
+	})
+}
+
+// searchOffsetLimitSort implements SearchOffsetLimitSortContext.
+func (b *BeerService) searchOffsetLimitSort(ctx context.Context, query string, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error) {
 	q := url.Values{
 		"q":      []string{query},
 		"offset": []string{strconv.Itoa(offset)},
@@ -39,32 +60,55 @@ func (b *BeerService) SearchOffsetLimitSort(query string, offset int, limit int,
 	var v struct {
 		Response struct {
 			Beers struct {
-				Count int `json:"count"`
-				Items []struct {
-					CheckinCount int        `json:"checkin_count"`
-					Beer         rawBeer    `json:"beer"`
-					Brewery      rawBrewery `json:"brewery"`
-				} `json:"items"`
+				Count int               `json:"count"`
+				Items []json.RawMessage `json:"items"`
 			} `json:"beers"`
 		} `json:"response"`
 	}
 
 	// Perform request for beer search
-	res, err := b.client.request("GET", "search/beer", nil, q, &v)
+	endpoint := "search/beer"
+	res, err := b.client.requestContext(ctx, "GET", endpoint, nil, q, &v)
 	if err != nil {
 		return nil, res, err
 	}
 
 	// Build result slice from struct
-	beers := make([]*Beer, v.Response.Beers.Count)
-	for i, item := range v.Response.Beers.Items {
+	var beers []*Beer
+	err = b.client.decodeItems(endpoint, "response.beers.items", v.Response.Beers.Count, v.Response.Beers.Items, func(decode func(v interface{}) error) error {
+		var item struct {
+			CheckinCount int        `json:"checkin_count"`
+			Beer         rawBeer    `json:"beer"`
+			Brewery      rawBrewery `json:"brewery"`
+		}
+		if err := decode(&item); err != nil {
+			return err
+		}
+
 		// Information about the beer itself
-		beers[i] = item.Beer.export()
-		beers[i].OverallCount = item.CheckinCount
+		beer := item.Beer.export()
+		beer.OverallCount = item.CheckinCount
 
 		// Information about the beer's brewery
-		beers[i].Brewery = item.Brewery.export()
-	}
+		beer.Brewery = item.Brewery.export()
+
+		beers = append(beers, beer)
+		return nil
+	})
 
-	return beers, res, nil
+	return beers, res, err
+}
+
+// AllSearch returns an iterator over all beers matching the search query,
+// paging through the results using SearchOffsetLimitSort.  Beers may be
+// sorted using any of the provided Sort constants with this package.
+//
+// One API call is made for every 50 beers, and no further calls are made
+// once iteration stops.  Iteration ends after yielding a request error, or if
+// ctx is canceled.
+func (b *BeerService) AllSearch(ctx context.Context, query string, sort Sort) iter.Seq2[*Beer, error] {
+	return offsetPages(ctx, b.client, "untappd.Beer.AllSearch", 50, func(ctx context.Context, offset int, limit int) ([]*Beer, error) {
+		beers, _, err := b.SearchOffsetLimitSortContext(ctx, query, offset, limit, sort)
+		return beers, err
+	})
 }
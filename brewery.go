@@ -1,6 +1,9 @@
 package untappd
 
-import "net/url"
+import (
+	"encoding/json"
+	"net/url"
+)
 
 // BreweryService is a "service" which allows access to API methods involving
 // breweries.
@@ -11,21 +14,63 @@ type BreweryService struct {
 // Brewery represents an Untappd brewery, and contains information about a
 // brewery's name, location, logo, and various other metadata.
 type Brewery struct {
-	ID       int
-	Name     string
-	Slug     string
-	Logo     url.URL
-	Country  string
-	Active   bool
-	Location BreweryLocation
-	Contact  BreweryContact
-	Type     string
-	TypeID   int
+	ID          int
+	Name        string
+	Slug        string
+	Logo        url.URL
+	Country     string
+	Description string
+	Active      bool
+	Location    BreweryLocation
+	Contact     BreweryContact
+	Type        string
+	TypeID      int
+
+	// Has this brewery claimed its Untappd page?
+	Claimed bool
+
+	// If this brewery has claimed its Untappd page, the user account which
+	// owns the page.  Only the account's UID and UserName are available.
+	Owner *User
+
+	// If available, number of users who follow this brewery.
+	FollowerCount int
+
+	// If available, total number of beers made by this brewery.
+	BeerCount int
+
+	// If available, global Untappd rating for this brewery.
+	Rating BreweryRating
+
+	// If available, struct containing this brewery's total checkins,
+	// unique users, and other various totals.
+	Stats BreweryStats
+
+	// If available, a list of popular beers made by this brewery.
+	Beers []*Beer
+
+	// If available, photos from recent checkins of beers made by
+	// this brewery.
+	Media []*Photo
+
+	// If available, recent checkins of beers made by this brewery.
+	Checkins []*Checkin
+
+	// The number of popular beers, photos, and checkins reported by the
+	// API, which may differ from the length of each list.
+	PopularBeerCount int
+	MediaCount       int
+	CheckinCount     int
+
+	// If Client.RawJSON is enabled, the original JSON representation of
+	// this brewery, including any fields which are not otherwise exported.
+	Raw json.RawMessage `json:",omitempty"`
 }
 
 // BreweryLocation represent's an Untappd brewery's location, and contains
 // information such as the brewery's city, state, and latitude/longitude.
 type BreweryLocation struct {
+	Address   string  `json:"brewery_address"`
 	City      string  `json:"brewery_city"`
 	State     string  `json:"brewery_state"`
 	Latitude  float64 `json:"lat"`
@@ -41,34 +86,133 @@ type BreweryContact struct {
 	URL       string `json:"url"`
 }
 
+// BreweryRating represents an Untappd brewery's global rating, and the
+// number of ratings which contributed to it.
+type BreweryRating struct {
+	Count int     `json:"count"`
+	Score float64 `json:"rating_score"`
+}
+
+// BreweryStats is a struct which contains various statistics regarding an
+// Untappd brewery.
+type BreweryStats struct {
+	TotalCount   int     `json:"total_count"`
+	UniqueCount  int     `json:"unique_count"`
+	MonthlyCount int     `json:"monthly_count"`
+	WeeklyCount  int     `json:"weekly_count"`
+	UserCount    int     `json:"user_count"`
+	AgeOnService float64 `json:"age_on_service"`
+}
+
 // rawBrewery is the raw JSON representation of an Untappd brewery.  Its data is
 // unmarshaled from JSON and then exported to a Brewery struct.
 type rawBrewery struct {
-	ID       int             `json:"brewery_id"`
-	Name     string          `json:"brewery_name"`
-	Slug     string          `json:"brewery_slug"`
-	Logo     responseURL     `json:"brewery_label"`
-	Country  string          `json:"country_name"`
-	Active   responseBool    `json:"brewery_active"`
-	Location BreweryLocation `json:"location"`
-	Contact  BreweryContact  `json:"contact"`
-	Type     string          `json:"brewery_type"`
-	TypeID   int             `json:"brewery_type_id"`
+	ID          int             `json:"brewery_id"`
+	Name        string          `json:"brewery_name"`
+	Slug        string          `json:"brewery_slug"`
+	Logo        responseURL     `json:"brewery_label"`
+	Country     string          `json:"country_name"`
+	Description string          `json:"brewery_description"`
+	Active      responseBool    `json:"brewery_active"`
+	Location    BreweryLocation `json:"location"`
+	Contact     BreweryContact  `json:"contact"`
+	Type        string          `json:"brewery_type"`
+	TypeID      int             `json:"brewery_type_id"`
+	BeerCount   int             `json:"beer_count"`
+	Rating      BreweryRating   `json:"rating"`
+	Stats       BreweryStats    `json:"stats"`
+
+	ClaimedStatus struct {
+		Claimed       bool   `json:"is_claimed"`
+		Slug          string `json:"claimed_slug"`
+		UID           int    `json:"uid"`
+		FollowerCount int    `json:"follower_count"`
+	} `json:"claimed_status"`
+
+	BeerList struct {
+		Count int `json:"count"`
+		Items []struct {
+			Beer    rawBeer    `json:"beer"`
+			Brewery rawBrewery `json:"brewery"`
+		} `json:"items"`
+	} `json:"beer_list"`
+
+	Media struct {
+		Count int         `json:"count"`
+		Items []*rawPhoto `json:"items"`
+	} `json:"media"`
+
+	Checkins struct {
+		Count int           `json:"count"`
+		Items []*rawCheckin `json:"items"`
+	} `json:"checkins"`
+
+	Raw json.RawMessage `json:"-"`
 }
 
 // export creates an exported Brewery from a rawBrewery struct, allowing for
 // more useful structures to be created for client consumption.
 func (r *rawBrewery) export() *Brewery {
-	return &Brewery{
-		ID:       r.ID,
-		Name:     r.Name,
-		Slug:     r.Slug,
-		Logo:     url.URL(r.Logo),
-		Country:  r.Country,
-		Active:   bool(r.Active),
-		Location: r.Location,
-		Contact:  r.Contact,
-		Type:     r.Type,
-		TypeID:   r.TypeID,
+	b := &Brewery{
+		ID:          r.ID,
+		Name:        r.Name,
+		Slug:        r.Slug,
+		Logo:        url.URL(r.Logo),
+		Country:     r.Country,
+		Description: r.Description,
+		Active:      bool(r.Active),
+		Location:    r.Location,
+		Contact:     r.Contact,
+		Type:        r.Type,
+		TypeID:      r.TypeID,
+		Claimed:     r.ClaimedStatus.Claimed,
+		BeerCount:   r.BeerCount,
+		Rating:      r.Rating,
+		Stats:       r.Stats,
+	}
+	b.FollowerCount = r.ClaimedStatus.FollowerCount
+
+	if r.ClaimedStatus.Claimed && (r.ClaimedStatus.UID != 0 || r.ClaimedStatus.Slug != "") {
+		owner := &rawUser{
+			UID:      r.ClaimedStatus.UID,
+			UserName: r.ClaimedStatus.Slug,
+		}
+		b.Owner = owner.export()
+	}
+
+	beers := make([]*Beer, 0, len(r.BeerList.Items))
+	for _, item := range r.BeerList.Items {
+		beer := item.Beer.export()
+		beer.Brewery = item.Brewery.export()
+
+		beers = append(beers, beer)
 	}
+	b.Beers = beers
+	b.PopularBeerCount = r.BeerList.Count
+
+	media := make([]*Photo, 0, len(r.Media.Items))
+	for _, item := range r.Media.Items {
+		if item == nil {
+			continue
+		}
+
+		media = append(media, item.export())
+	}
+	b.Media = media
+	b.MediaCount = r.Media.Count
+
+	checkins := make([]*Checkin, 0, len(r.Checkins.Items))
+	for _, item := range r.Checkins.Items {
+		if item == nil {
+			continue
+		}
+
+		checkins = append(checkins, item.export())
+	}
+	b.Checkins = checkins
+	b.CheckinCount = r.Checkins.Count
+
+	b.Raw = r.Raw
+
+	return b
 }
@@ -0,0 +1,119 @@
+package untappd
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Beers queries for information about beers made by the specified Brewery.
+// The ID parameter specifies the Brewery ID, which will return a list of
+// beers made by a given Brewery.
+//
+// This method returns up to 25 of the Brewery's beers.  For more granular
+// control, and to page through and sort the beers list, use
+// BeersOffsetLimitSort instead.  To retrieve every beer made by a Brewery,
+// use AllBeers.
+func (b *BreweryService) Beers(id int) ([]*Beer, *http.Response, error) {
+	return b.BeersContext(context.Background(), id)
+}
+
+// BeersContext is like Beers, but uses ctx for the request.
+func (b *BreweryService) BeersContext(ctx context.Context, id int) ([]*Beer, *http.Response, error) {
+	// Use default parameters as specified by API
+	return b.BeersOffsetLimitSortContext(ctx, id, 0, 25, SortDate)
+}
+
+// BeersOffsetLimitSort queries for information about beers made by the
+// specified Brewery, but also accepts offset, limit, and sort parameters to
+// enable paging and sorting through more than 25 beers.  The ID parameter
+// specifies the Brewery ID, which will return a list of beers made by a given
+// Brewery.  Beers may be sorted using any of the provided Sort constants with
+// this package.
+//
+// 50 beers is the maximum number of beers which may be returned by one call.
+func (b *BreweryService) BeersOffsetLimitSort(id int, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error) {
+	return b.BeersOffsetLimitSortContext(context.Background(), id, offset, limit, sort)
+}
+
+// BeersOffsetLimitSortContext is like BeersOffsetLimitSort, but uses ctx for
+// the request.
+func (b *BreweryService) BeersOffsetLimitSortContext(ctx context.Context, id int, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error) {
+	return traceCall(ctx, b.client, "untappd.Brewery.Beers", func(ctx context.Context) ([]*Beer, *http.Response, error) {
+		return b.beersOffsetLimitSort(ctx, id, offset, limit, sort)
+	})
+}
+
+// beersOffsetLimitSort implements BeersOffsetLimitSortContext.
+func (b *BreweryService) beersOffsetLimitSort(ctx context.Context, id int, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error) {
+	q := url.Values{
+		"offset": []string{strconv.Itoa(offset)},
+		"limit":  []string{strconv.Itoa(limit)},
+		"sort":   []string{string(sort)},
+	}
+
+	// Temporary struct to unmarshal beers JSON
+	var v struct {
+		Response struct {
+			Brewery rawBrewery `json:"brewery"`
+			Beers   struct {
+				Count int               `json:"count"`
+				Items []json.RawMessage `json:"items"`
+			} `json:"beers"`
+		} `json:"response"`
+	}
+
+	// Perform request for brewery beers by ID
+	endpoint := "brewery/beer_list/" + strconv.Itoa(id)
+	res, err := b.client.requestContext(ctx, "GET", endpoint, nil, q, &v)
+	if err != nil {
+		return nil, res, err
+	}
+
+	// Build result slice from struct
+	var beers []*Beer
+	err = b.client.decodeItems(endpoint, "response.beers.items", v.Response.Beers.Count, v.Response.Beers.Items, func(decode func(v interface{}) error) error {
+		var item struct {
+			Beer    rawBeer    `json:"beer"`
+			Brewery rawBrewery `json:"brewery"`
+		}
+		if err := decode(&item); err != nil {
+			return err
+		}
+
+		// Information about the beer itself
+		beer := item.Beer.export()
+
+		// Information about the beer's brewery.  If the item does not
+		// carry its own brewery, use the brewery for the whole list.
+		brewery := item.Brewery
+		if brewery.ID == 0 {
+			brewery = v.Response.Brewery
+		}
+		beer.Brewery = brewery.export()
+
+		beers = append(beers, beer)
+		return nil
+	})
+
+	return beers, res, err
+}
+
+// AllBeers returns an iterator over every beer made by the specified Brewery,
+// paging through the beers list using BeersOffsetLimitSort.  The ID parameter
+// specifies the Brewery ID, and beers may be sorted using any of the provided
+// Sort constants with this package.
+//
+// One API call is made for every 50 beers, so breweries with large catalogs
+// may consume a significant amount of rate limit.  No further calls are made
+// once iteration stops.  Iteration ends after yielding a request error, or if
+// ctx is canceled.
+func (b *BreweryService) AllBeers(ctx context.Context, id int, sort Sort) iter.Seq2[*Beer, error] {
+	return offsetPages(ctx, b.client, "untappd.Brewery.AllBeers", 50, func(ctx context.Context, offset int, limit int) ([]*Beer, error) {
+		beers, _, err := b.BeersOffsetLimitSortContext(ctx, id, offset, limit, sort)
+		return beers, err
+	})
+}
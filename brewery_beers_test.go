@@ -0,0 +1,262 @@
+package untappd
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestClientBreweryBeersOK verifies that Client.Brewery.Beers always sets the
+// appropriate default offset, limit, and sort values.
+func TestClientBreweryBeersOK(t *testing.T) {
+	offset := "0"
+	limit := "25"
+	sort := "date"
+
+	c, done := breweryBeersTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		assertParameters(t, r, url.Values{
+			"offset": []string{offset},
+			"limit":  []string{limit},
+			"sort":   []string{sort},
+		})
+
+		// Empty JSON response since we already passed checks
+		w.Write([]byte("{}"))
+	})
+	defer done()
+
+	if _, _, err := c.Brewery.Beers(1); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestClientBreweryBeersOffsetLimitSortBadBrewery verifies that
+// Client.Brewery.BeersOffsetLimitSort returns an error when an invalid
+// brewery is queried.
+func TestClientBreweryBeersOffsetLimitSortBadBrewery(t *testing.T) {
+	c, done := breweryBeersTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(invalidBreweryErrJSON)
+	})
+	defer done()
+
+	_, _, err := c.Brewery.BeersOffsetLimitSort(-1, 0, 25, SortDate)
+	assertInvalidBreweryErr(t, err)
+}
+
+// TestClientBreweryBeersOffsetLimitSortOK verifies that
+// Client.Brewery.BeersOffsetLimitSort returns a valid beers list, when used
+// with correct parameters.
+func TestClientBreweryBeersOffsetLimitSortOK(t *testing.T) {
+	breweryID := 1
+	sBreweryID := strconv.Itoa(breweryID)
+
+	offset := 10
+	sOffset := strconv.Itoa(offset)
+
+	limit := 50
+	sLimit := strconv.Itoa(limit)
+
+	sort := SortHighestRated
+
+	c, done := breweryBeersTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		path := "/v4/brewery/beer_list/" + sBreweryID + "/"
+		if p := r.URL.Path; p != path {
+			t.Fatalf("unexpected URL path: %q != %q", p, path)
+		}
+
+		assertParameters(t, r, url.Values{
+			"offset": []string{sOffset},
+			"limit":  []string{sLimit},
+			"sort":   []string{string(sort)},
+		})
+
+		w.Write(breweryBeersJSON)
+	})
+	defer done()
+
+	beers, _, err := c.Brewery.BeersOffsetLimitSort(breweryID, offset, limit, sort)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []*Beer{
+		&Beer{
+			ID:    4499,
+			Name:  "Two Hearted Ale",
+			Style: "IPA - American",
+			Brewery: &Brewery{
+				ID:   1,
+				Name: "Bell's Brewery, Inc.",
+			},
+		},
+		&Beer{
+			ID:    3939,
+			Name:  "Expedition Stout",
+			Style: "Stout - Russian Imperial",
+			Brewery: &Brewery{
+				ID:   1,
+				Name: "Bell's Brewery, Inc.",
+			},
+		},
+	}
+
+	if l := len(beers); l != len(expected) {
+		t.Fatalf("unexpected number of beers: %d != %d", l, len(expected))
+	}
+
+	for i := range beers {
+		if beers[i].ID != expected[i].ID {
+			t.Fatalf("unexpected beer ID: %d != %d", beers[i].ID, expected[i].ID)
+		}
+		if beers[i].Name != expected[i].Name {
+			t.Fatalf("unexpected beer Name: %q != %q", beers[i].Name, expected[i].Name)
+		}
+		if beers[i].Style != expected[i].Style {
+			t.Fatalf("unexpected beer Style: %q != %q", beers[i].Style, expected[i].Style)
+		}
+		if beers[i].Brewery.ID != expected[i].Brewery.ID {
+			t.Fatalf("unexpected beer Brewery.ID: %d != %d", beers[i].Brewery.ID, expected[i].Brewery.ID)
+		}
+		if beers[i].Brewery.Name != expected[i].Brewery.Name {
+			t.Fatalf("unexpected beer Brewery.Name: %q != %q", beers[i].Brewery.Name, expected[i].Brewery.Name)
+		}
+	}
+}
+
+// TestClientBreweryAllBeersOK verifies that Client.Brewery.AllBeers pages
+// through a brewery's beers until a short page is returned.
+func TestClientBreweryAllBeersOK(t *testing.T) {
+	var offsets []string
+	c, done := breweryBeersTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		assertParameters(t, r, url.Values{
+			"limit": []string{"50"},
+			"sort":  []string{string(SortHighestRated)},
+		})
+
+		offset := r.URL.Query().Get("offset")
+		offsets = append(offsets, offset)
+
+		// Return a full page on the first call, and a short page after
+		if offset == "0" {
+			w.Write(fullBreweryBeersPage())
+			return
+		}
+
+		w.Write(breweryBeersJSON)
+	})
+	defer done()
+
+	var beers []*Beer
+	for b, err := range c.Brewery.AllBeers(context.Background(), 1, SortHighestRated) {
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		beers = append(beers, b)
+	}
+
+	if l := len(beers); l != 52 {
+		t.Fatalf("unexpected number of beers: %d != %d", l, 52)
+	}
+	if s := strings.Join(offsets, ","); s != "0,50" {
+		t.Fatalf("unexpected offsets: %q != %q", s, "0,50")
+	}
+}
+
+// TestClientBreweryAllBeersBadBrewery verifies that Client.Brewery.AllBeers
+// returns an error when an invalid brewery is queried.
+func TestClientBreweryAllBeersBadBrewery(t *testing.T) {
+	c, done := breweryBeersTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(invalidBreweryErrJSON)
+	})
+	defer done()
+
+	var err error
+	for _, err = range c.Brewery.AllBeers(context.Background(), -1, SortDate) {
+	}
+	assertInvalidBreweryErr(t, err)
+}
+
+// breweryBeersTestClient builds upon testClient, and adds additional sanity checks
+// for tests which target the brewery beers API.
+func breweryBeersTestClient(t *testing.T, fn func(t *testing.T, w http.ResponseWriter, r *http.Request)) (*Client, func()) {
+	return testClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		// Always GET request
+		method := "GET"
+		if m := r.Method; m != method {
+			t.Fatalf("unexpected HTTP method: %q != %q", m, method)
+		}
+
+		// Always uses specific path prefix
+		prefix := "/v4/brewery/beer_list/"
+		if p := r.URL.Path; !strings.HasPrefix(p, prefix) {
+			t.Fatalf("unexpected HTTP path prefix: %q != %q", p, prefix)
+		}
+
+		// Guard against panics
+		if fn != nil {
+			fn(t, w, r)
+		}
+	})
+}
+
+// fullBreweryBeersPage generates a brewery beers JSON response containing
+// a full page of 50 beers.
+func fullBreweryBeersPage() []byte {
+	items := make([]string, 50)
+	for i := range items {
+		items[i] = `{"beer":{"bid":` + strconv.Itoa(i+1) + `}}`
+	}
+
+	return []byte(`{"response":{"brewery":{"brewery_id":1},"beers":{"count":50,"items":[` +
+		strings.Join(items, ",") + `]}}}`)
+}
+
+// Canned brewery beers JSON response.  The first item carries its own
+// brewery, while the second relies on the brewery for the whole list.
+var breweryBeersJSON = []byte(`{
+  "meta": {
+    "code": 200,
+    "response_time": {
+      "time": 0,
+      "measure": "seconds"
+    }
+  },
+  "notifications": {},
+  "response": {
+    "brewery": {
+      "brewery_id": 1,
+      "brewery_name": "Bell's Brewery, Inc."
+    },
+    "beers": {
+      "count": 2,
+      "items": [
+        {
+          "has_had": false,
+          "beer": {
+            "bid": 4499,
+            "beer_name": "Two Hearted Ale",
+            "beer_style": "IPA - American"
+          },
+          "brewery": {
+            "brewery_id": 1,
+            "brewery_name": "Bell's Brewery, Inc."
+          }
+        },
+        {
+          "has_had": false,
+          "beer": {
+            "bid": 3939,
+            "beer_name": "Expedition Stout",
+            "beer_style": "Stout - Russian Imperial"
+          }
+        }
+      ]
+    }
+  }
+}`)
@@ -1,6 +1,8 @@
 package untappd
 
 import (
+	"context"
+	"iter"
 	"math"
 	"net/http"
 	"net/url"
@@ -16,9 +18,14 @@ import (
 // For more granular control, and to page through the checkins list using ID
 // parameters, use CheckinsMinMaxIDLimit instead.
 func (b *BreweryService) Checkins(id int) ([]*Checkin, *http.Response, error) {
+	return b.CheckinsContext(context.Background(), id)
+}
+
+// CheckinsContext is like Checkins, but uses ctx for the request.
+func (b *BreweryService) CheckinsContext(ctx context.Context, id int) ([]*Checkin, *http.Response, error) {
 	// Use default parameters as specified by API.  Max ID is somewhat
 	// arbitrary, but should provide plenty of headroom, just in case.
-	return b.CheckinsMinMaxIDLimit(id, 0, math.MaxInt32, 25)
+	return b.CheckinsMinMaxIDLimitContext(ctx, id, 0, math.MaxInt32, 25)
 }
 
 // CheckinsMinMaxIDLimit queries for information about recent checkins for beers
@@ -30,9 +37,37 @@ func (b *BreweryService) Checkins(id int) ([]*Checkin, *http.Response, error) {
 // 25 checkins is the maximum number of checkins which may be returned by
 // one call.
 func (b *BreweryService) CheckinsMinMaxIDLimit(id int, minID int, maxID int, limit int) ([]*Checkin, *http.Response, error) {
-	return b.client.getCheckins("brewery/checkins/"+strconv.Itoa(id), url.Values{
+	return b.CheckinsMinMaxIDLimitContext(context.Background(), id, minID, maxID, limit)
+}
+
+// CheckinsMinMaxIDLimitContext is like CheckinsMinMaxIDLimit, but uses ctx for
+// the request.
+func (b *BreweryService) CheckinsMinMaxIDLimitContext(ctx context.Context, id int, minID int, maxID int, limit int) ([]*Checkin, *http.Response, error) {
+	return traceCall(ctx, b.client, "untappd.Brewery.Checkins", func(ctx context.Context) ([]*Checkin, *http.Response, error) {
+		return b.checkinsMinMaxIDLimit(ctx, id, minID, maxID, limit)
+	})
+}
+
+// checkinsMinMaxIDLimit implements CheckinsMinMaxIDLimitContext.
+func (b *BreweryService) checkinsMinMaxIDLimit(ctx context.Context, id int, minID int, maxID int, limit int) ([]*Checkin, *http.Response, error) {
+	return b.client.getCheckins(ctx, "brewery/checkins/"+strconv.Itoa(id), url.Values{
 		"min_id": []string{strconv.Itoa(minID)},
 		"max_id": []string{strconv.Itoa(maxID)},
 		"limit":  []string{strconv.Itoa(limit)},
 	})
 }
+
+// AllCheckins returns an iterator over all recent checkins for beers made by
+// a Brewery, newest first, paging through the checkins list using
+// CheckinsMinMaxIDLimit.  The ID parameter specifies the Brewery whose
+// checkins will be returned.
+//
+// One API call is made for every 25 checkins, and no further calls are made
+// once iteration stops.  Iteration ends after yielding a request error, or if
+// ctx is canceled.
+func (b *BreweryService) AllCheckins(ctx context.Context, id int) iter.Seq2[*Checkin, error] {
+	return checkinPages(ctx, b.client, "untappd.Brewery.AllCheckins", math.MaxInt32, 25, func(ctx context.Context, maxID int, limit int) ([]*Checkin, error) {
+		checkins, _, err := b.CheckinsMinMaxIDLimitContext(ctx, id, 0, maxID, limit)
+		return checkins, err
+	})
+}
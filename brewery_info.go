@@ -1,6 +1,7 @@
 package untappd
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -10,6 +11,18 @@ import (
 // If the compact parameter is set to 'true', only basic brewery information will
 // be populated.
 func (b *BreweryService) Info(id int, compact bool) (*Brewery, *http.Response, error) {
+	return b.InfoContext(context.Background(), id, compact)
+}
+
+// InfoContext is like Info, but uses ctx for the request.
+func (b *BreweryService) InfoContext(ctx context.Context, id int, compact bool) (*Brewery, *http.Response, error) {
+	return traceCall(ctx, b.client, "untappd.Brewery.Info", func(ctx context.Context) (*Brewery, *http.Response, error) {
+		return b.info(ctx, id, compact)
+	})
+}
+
+// info implements InfoContext.
+func (b *BreweryService) info(ctx context.Context, id int, compact bool) (*Brewery, *http.Response, error) {
 	// Determine if a compact response is requested
 	q := url.Values{}
 	if compact {
@@ -24,10 +37,25 @@ func (b *BreweryService) Info(id int, compact bool) (*Brewery, *http.Response, e
 	}
 
 	// Perform request for brewery information by ID
-	res, err := b.client.request("GET", "brewery/info/"+strconv.Itoa(id), nil, q, &v)
+	res, err := b.client.requestContext(ctx, "GET", "brewery/info/"+strconv.Itoa(id), nil, q, &v)
 	if err != nil {
 		return nil, res, err
 	}
 
 	return v.Response.Brewery.export(), res, nil
 }
+
+// InfoMany queries for information about each Brewery with the specified IDs,
+// using up to Client.BatchConcurrency concurrent requests.  The returned
+// slice contains a Brewery for each of ids, in the same order.
+//
+// If information about any Brewery could not be retrieved, InfoMany returns
+// ItemErrors, and the Brewery at the index of each failure is nil.  Once ctx
+// is canceled or the rate limit is exhausted, ErrRateLimitExhausted or
+// the context's error is returned for each remaining Brewery.
+func (b *BreweryService) InfoMany(ctx context.Context, ids []int, compact bool) ([]*Brewery, error) {
+	return batch(ctx, b.client, "untappd.Brewery.InfoMany", ids, func(ctx context.Context, id int) (*Brewery, error) {
+		brewery, _, err := b.InfoContext(ctx, id, compact)
+		return brewery, err
+	})
+}
@@ -93,6 +93,81 @@ func TestClientBreweryInfoOK(t *testing.T) {
 	if n := b.Contact.Twitter; n != breweryContactTwitter {
 		t.Fatalf("unexpected Brewery.TypeID: %q != %q", n, breweryContactTwitter)
 	}
+	breweryAddress := "8938 Krum Ave."
+	if n := b.Location.Address; n != breweryAddress {
+		t.Fatalf("unexpected Brewery.Location.Address: %q != %q", n, breweryAddress)
+	}
+	breweryDescription := "Bell's Brewery, Inc. began in 1985 with a quest for better beer."
+	if n := b.Description; n != breweryDescription {
+		t.Fatalf("unexpected Brewery.Description: %q != %q", n, breweryDescription)
+	}
+	if !b.Claimed {
+		t.Fatal("expected Brewery.Claimed to be true")
+	}
+	if b.Owner == nil {
+		t.Fatal("expected Brewery.Owner to be set")
+	}
+	if uid := b.Owner.UID; uid != 123456 {
+		t.Fatalf("unexpected Brewery.Owner.UID: %d != %d", uid, 123456)
+	}
+	if n := b.Owner.UserName; n != "bellsbrewery" {
+		t.Fatalf("unexpected Brewery.Owner.UserName: %q != %q", n, "bellsbrewery")
+	}
+	if n := b.FollowerCount; n != 12345 {
+		t.Fatalf("unexpected Brewery.FollowerCount: %d != %d", n, 12345)
+	}
+	beerCount := 207
+	if n := b.BeerCount; n != beerCount {
+		t.Fatalf("unexpected Brewery.BeerCount: %d != %d", n, beerCount)
+	}
+
+	rating := BreweryRating{
+		Count: 1053497,
+		Score: 3.923,
+	}
+	if r := b.Rating; r != rating {
+		t.Fatalf("unexpected Brewery.Rating: %+v != %+v", r, rating)
+	}
+	stats := BreweryStats{
+		TotalCount:   2211797,
+		MonthlyCount: 35143,
+		WeeklyCount:  7977,
+		UserCount:    4,
+		AgeOnService: 2008.95,
+	}
+	if s := b.Stats; s != stats {
+		t.Fatalf("unexpected Brewery.Stats: %+v != %+v", s, stats)
+	}
+
+	beerName := "Two Hearted Ale"
+	if l := len(b.Beers); l != 1 {
+		t.Fatalf("unexpected Brewery.Beers length: %d != %d", l, 1)
+	}
+	if n := b.Beers[0].Name; n != beerName {
+		t.Fatalf("unexpected Brewery.Beers[0].Name: %q != %q", n, beerName)
+	}
+	if n := b.Beers[0].Brewery.Name; n != breweryName {
+		t.Fatalf("unexpected Brewery.Beers[0].Brewery.Name: %q != %q", n, breweryName)
+	}
+
+	if l := len(b.Media); l != 1 {
+		t.Fatalf("unexpected Brewery.Media length: %d != %d", l, 1)
+	}
+	photoID := 24739916
+	if id := b.Media[0].ID; id != photoID {
+		t.Fatalf("unexpected Brewery.Media[0].ID: %d != %d", id, photoID)
+	}
+
+	if l := len(b.Checkins); l != 1 {
+		t.Fatalf("unexpected Brewery.Checkins length: %d != %d", l, 1)
+	}
+	checkinID := 133319904
+	if id := b.Checkins[0].ID; id != checkinID {
+		t.Fatalf("unexpected Brewery.Checkins[0].ID: %d != %d", id, checkinID)
+	}
+	if n := b.Checkins[0].Beer.Name; n != beerName {
+		t.Fatalf("unexpected Brewery.Checkins[0].Beer.Name: %q != %q", n, beerName)
+	}
 }
 
 // breweryInfoTestClient builds upon testClient, and adds additional sanity checks
@@ -136,10 +211,112 @@ var bellsBreweryJSON = []byte(`
       "brewery_slug": "bells-brewery-inc",
       "brewery_type": "Micro Brewery",
       "brewery_type_id": 2,
+      "brewery_description": "Bell's Brewery, Inc. began in 1985 with a quest for better beer.",
+      "beer_count": 207,
+      "claimed_status": {
+        "is_claimed": true,
+        "claimed_slug": "bellsbrewery",
+        "uid": 123456,
+        "follow_status": false,
+        "follower_count": 12345
+      },
       "contact": {
         "twitter": "BellsBrewery",
         "facebook": "",
         "url": ""
+      },
+      "location": {
+        "brewery_address": "8938 Krum Ave.",
+        "brewery_city": "Galesburg",
+        "brewery_state": "MI",
+        "lat": 42.2843,
+        "lng": -85.4538
+      },
+      "rating": {
+        "count": 1053497,
+        "rating_score": 3.923
+      },
+      "stats": {
+        "total_count": 2211797,
+        "unique_count": 0,
+        "monthly_count": 35143,
+        "weekly_count": 7977,
+        "user_count": 4,
+        "age_on_service": 2008.95
+      },
+      "media": {
+        "count": 1,
+        "items": [
+          {
+            "photo_id": 24739916,
+            "photo": {
+              "photo_img_sm": "https://untappd.akamaized.net/photo/2016_01_01/two_hearted_100x100.jpg",
+              "photo_img_md": "https://untappd.akamaized.net/photo/2016_01_01/two_hearted_320x320.jpg",
+              "photo_img_lg": "https://untappd.akamaized.net/photo/2016_01_01/two_hearted_640x640.jpg",
+              "photo_img_og": "https://untappd.akamaized.net/photo/2016_01_01/two_hearted_raw.jpg"
+            },
+            "created_at": "Fri, 01 Jan 2016 22:21:05 +0000",
+            "checkin_id": 133319904,
+            "user": {
+              "user_name": "gregavola"
+            },
+            "beer": {
+              "bid": 4499,
+              "beer_name": "Two Hearted Ale"
+            },
+            "brewery": {
+              "brewery_id": 1,
+              "brewery_name": "Bell's Brewery, Inc."
+            },
+            "venue": []
+          }
+        ]
+      },
+      "checkins": {
+        "count": 1,
+        "items": [
+          {
+            "checkin_id": 133319904,
+            "created_at": "Fri, 01 Jan 2016 22:21:05 +0000",
+            "checkin_comment": "Classic.",
+            "rating_score": 4.5,
+            "user": {
+              "user_name": "gregavola"
+            },
+            "beer": {
+              "bid": 4499,
+              "beer_name": "Two Hearted Ale"
+            },
+            "brewery": {
+              "brewery_id": 1,
+              "brewery_name": "Bell's Brewery, Inc."
+            },
+            "venue": []
+          }
+        ]
+      },
+      "beer_list": {
+        "is_super": false,
+        "sort": "",
+        "filter": "",
+        "count": 1,
+        "items": [
+          {
+            "has_had": false,
+            "total_count": 1132456,
+            "beer": {
+              "bid": 4499,
+              "beer_name": "Two Hearted Ale",
+              "beer_style": "IPA - American",
+              "beer_abv": 7
+            },
+            "brewery": {
+              "brewery_id": 1,
+              "brewery_name": "Bell's Brewery, Inc."
+            }
+          }
+        ],
+        "beer_count": 207
       }
     }
   }
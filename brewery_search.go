@@ -1,6 +1,9 @@
 package untappd
 
 import (
+	"context"
+	"encoding/json"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -11,8 +14,13 @@ import (
 // This method returns up to 25 search results.  For more granular control,
 // and to page through the results list, use SearchOffsetLimit instead.
 func (b *BreweryService) Search(query string) ([]*Brewery, *http.Response, error) {
+	return b.SearchContext(context.Background(), query)
+}
+
+// SearchContext is like Search, but uses ctx for the request.
+func (b *BreweryService) SearchContext(ctx context.Context, query string) ([]*Brewery, *http.Response, error) {
 	// Use default parameters as specified by API
-	return b.SearchOffsetLimit(query, 0, 25)
+	return b.SearchOffsetLimitContext(ctx, query, 0, 25)
 }
 
 // SearchOffsetLimit searches for information about breweries, using the specified
@@ -21,6 +29,19 @@ func (b *BreweryService) Search(query string) ([]*Brewery, *http.Response, error
 //
 // 50 breweries is the maximum number of results which may be returned by one call.
 func (b *BreweryService) SearchOffsetLimit(query string, offset int, limit int) ([]*Brewery, *http.Response, error) {
+	return b.SearchOffsetLimitContext(context.Background(), query, offset, limit)
+}
+
+// SearchOffsetLimitContext is like SearchOffsetLimit, but uses ctx for the
+// request.
+func (b *BreweryService) SearchOffsetLimitContext(ctx context.Context, query string, offset int, limit int) ([]*Brewery, *http.Response, error) {
+	return traceCall(ctx, b.client, "untappd.Brewery.Search", func(ctx context.Context) ([]*Brewery, *http.Response, error) {
+		return b.searchOffsetLimit(ctx, query, offset, limit)
+	})
+}
+
+// searchOffsetLimit implements SearchOffsetLimitContext.
+func (b *BreweryService) searchOffsetLimit(ctx context.Context, query string, offset int, limit int) ([]*Brewery, *http.Response, error) {
 	q := url.Values{
 		"q":      []string{query},
 		"offset": []string{strconv.Itoa(offset)},
@@ -31,25 +52,45 @@ func (b *BreweryService) SearchOffsetLimit(query string, offset int, limit int)
 	var v struct {
 		Response struct {
 			Brewery struct {
-				Count int `json:"count"`
-				Items []struct {
-					Brewery rawBrewery `json:"brewery"`
-				} `json:"items"`
+				Count int               `json:"count"`
+				Items []json.RawMessage `json:"items"`
 			} `json:"brewery"`
 		} `json:"response"`
 	}
 
 	// Perform request for brewery search
-	res, err := b.client.request("GET", "search/brewery", nil, q, &v)
+	endpoint := "search/brewery"
+	res, err := b.client.requestContext(ctx, "GET", endpoint, nil, q, &v)
 	if err != nil {
 		return nil, res, err
 	}
 
 	// Build result slice from struct
-	breweries := make([]*Brewery, v.Response.Brewery.Count)
-	for i := range v.Response.Brewery.Items {
-		breweries[i] = v.Response.Brewery.Items[i].Brewery.export()
-	}
+	var breweries []*Brewery
+	err = b.client.decodeItems(endpoint, "response.brewery.items", v.Response.Brewery.Count, v.Response.Brewery.Items, func(decode func(v interface{}) error) error {
+		var item struct {
+			Brewery rawBrewery `json:"brewery"`
+		}
+		if err := decode(&item); err != nil {
+			return err
+		}
+
+		breweries = append(breweries, item.Brewery.export())
+		return nil
+	})
 
-	return breweries, res, nil
+	return breweries, res, err
+}
+
+// AllSearch returns an iterator over all breweries matching the search query,
+// paging through the results using SearchOffsetLimit.
+//
+// One API call is made for every 50 breweries, and no further calls are made
+// once iteration stops.  Iteration ends after yielding a request error, or if
+// ctx is canceled.
+func (b *BreweryService) AllSearch(ctx context.Context, query string) iter.Seq2[*Brewery, error] {
+	return offsetPages(ctx, b.client, "untappd.Brewery.AllSearch", 50, func(ctx context.Context, offset int, limit int) ([]*Brewery, error) {
+		breweries, _, err := b.SearchOffsetLimitContext(ctx, query, offset, limit)
+		return breweries, err
+	})
 }
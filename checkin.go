@@ -1,6 +1,8 @@
 package untappd
 
 import (
+	"encoding/json"
+	"strings"
 	"time"
 )
 
@@ -20,6 +22,12 @@ type Checkin struct {
 	// If applicable, the specified user's rating for this beer.
 	UserRating float64
 
+	// If available, how this beer was served, such as ServingDraft.
+	ServingStyle ServingStyle
+
+	// If available, flavor profile tags describing this beer.
+	Flavors []FlavorTag
+
 	// The user checking in.
 	User *User
 
@@ -35,6 +43,17 @@ type Checkin struct {
 	// will be nil.
 	Venue *Venue
 
+	// If available, information regarding the venue where this beer was
+	// purchased.  If a purchase venue was not added to the checkin, this
+	// member will be nil.
+	PurchaseVenue *Venue
+
+	// Photos attached to this checkin.
+	Media []*CheckinMedia
+
+	// The application used to submit this checkin.
+	Source CheckinSource
+
 	// Badges earned when this checkin was submitted.
 	Badges []*Badge
 
@@ -43,6 +62,31 @@ type Checkin struct {
 
 	// Comments by Untappd users about this checkin.
 	Comments []*Comment
+
+	// The number of photos, badges, toasts, and comments reported by the
+	// API.  The API may return only the most recent toasts and comments,
+	// so these may differ from the length of each list.
+	MediaCount   int
+	BadgeCount   int
+	ToastCount   int
+	CommentCount int
+
+	// If Client.RawJSON is enabled, the original JSON representation of
+	// this checkin, including any fields which are not otherwise exported.
+	Raw json.RawMessage `json:",omitempty"`
+}
+
+// CheckinMedia represents a photo attached to an Untappd checkin, and contains
+// links to several sizes of the photo.
+type CheckinMedia struct {
+	ID     int
+	Images PhotoImages
+}
+
+// CheckinSource represents the application used to submit an Untappd checkin.
+type CheckinSource struct {
+	AppName    string `json:"app_name"`
+	AppWebsite string `json:"app_website"`
 }
 
 // rawCheckin is the raw JSON representation of an Untappd checkin.  Its data is
@@ -57,34 +101,59 @@ type rawCheckin struct {
 	Comment    string        `json:"checkin_comment"`
 	Created    responseTime  `json:"created_at"`
 
+	ServingStyle  string        `json:"serving_type"`
+	Flavors       []string      `json:"flavor_profile"`
+	PurchaseVenue responseVenue `json:"purchase_venue"`
+	Source        CheckinSource `json:"source"`
+
+	Media struct {
+		Count int `json:"count"`
+		Items []struct {
+			ID     int            `json:"photo_id"`
+			Images rawPhotoImages `json:"photo"`
+		} `json:"items"`
+	} `json:"media"`
+
 	Badges struct {
 		Count int         `json:"count"`
 		Items []*rawBadge `json:"items"`
 	} `json:"badges"`
 
 	Toasts struct {
-		Count int         `json:"count"`
-		Items []*rawToast `json:"items"`
+		Count      int         `json:"count"`
+		TotalCount int         `json:"total_count"`
+		Items      []*rawToast `json:"items"`
 	} `json:"toasts"`
 
 	Comments struct {
-		Count int           `json:"count"`
-		Items []*rawComment `json:"items"`
+		Count      int           `json:"count"`
+		TotalCount int           `json:"total_count"`
+		Items      []*rawComment `json:"items"`
 	} `json:"comments"`
+
+	Raw json.RawMessage `json:"-"`
 }
 
 // export creates an exported Checkin from a rawCheckin struct, allowing for more
 // useful structures to be created for client consumption.
 func (r *rawCheckin) export() *Checkin {
 	c := &Checkin{
-		ID:         r.ID,
-		Comment:    r.Comment,
-		UserRating: r.UserRating,
-		Created:    time.Time(r.Created),
-		Beer:       r.Beer.export(),
-		Brewery:    r.Brewery.export(),
-		User:       r.User.export(),
+		ID:           r.ID,
+		Comment:      r.Comment,
+		UserRating:   r.UserRating,
+		ServingStyle: ServingStyle(strings.ToLower(r.ServingStyle)),
+		Created:      time.Time(r.Created),
+		Beer:         r.Beer.export(),
+		Brewery:      r.Brewery.export(),
+		User:         r.User.export(),
+		Source:       r.Source,
+	}
+
+	flavors := make([]FlavorTag, len(r.Flavors))
+	for i := range r.Flavors {
+		flavors[i] = FlavorTag(strings.ToLower(r.Flavors[i]))
 	}
+	c.Flavors = flavors
 
 	// If no venue was set in the response JSON, venue will be nil
 	if r.Venue.ID != 0 && r.Venue.Name != "" {
@@ -93,23 +162,62 @@ func (r *rawCheckin) export() *Checkin {
 		c.Venue = rv.export()
 	}
 
-	badges := make([]*Badge, r.Badges.Count)
-	for i := range r.Badges.Items {
-		badges[i] = r.Badges.Items[i].export()
+	// Purchase venue is handled in the same way as venue
+	if r.PurchaseVenue.ID != 0 && r.PurchaseVenue.Name != "" {
+		rv := rawVenue(r.PurchaseVenue)
+		c.PurchaseVenue = rv.export()
+	}
+
+	media := make([]*CheckinMedia, 0, len(r.Media.Items))
+	for _, item := range r.Media.Items {
+		media = append(media, &CheckinMedia{
+			ID:     item.ID,
+			Images: item.Images.export(),
+		})
+	}
+	c.Media = media
+	c.MediaCount = r.Media.Count
+
+	badges := make([]*Badge, 0, len(r.Badges.Items))
+	for _, item := range r.Badges.Items {
+		if item == nil {
+			continue
+		}
+
+		badges = append(badges, item.export())
 	}
 	c.Badges = badges
+	c.BadgeCount = r.Badges.Count
+
+	toasts := make([]*Toast, 0, len(r.Toasts.Items))
+	for _, item := range r.Toasts.Items {
+		if item == nil {
+			continue
+		}
 
-	toasts := make([]*Toast, r.Toasts.Count)
-	for i := range r.Toasts.Items {
-		toasts[i] = r.Toasts.Items[i].export()
+		toasts = append(toasts, item.export())
 	}
 	c.Toasts = toasts
+	c.ToastCount = r.Toasts.Count
+	if r.Toasts.TotalCount > 0 {
+		c.ToastCount = r.Toasts.TotalCount
+	}
 
-	comments := make([]*Comment, r.Comments.Count)
-	for i := range r.Comments.Items {
-		comments[i] = r.Comments.Items[i].export()
+	comments := make([]*Comment, 0, len(r.Comments.Items))
+	for _, item := range r.Comments.Items {
+		if item == nil {
+			continue
+		}
+
+		comments = append(comments, item.export())
 	}
 	c.Comments = comments
+	c.CommentCount = r.Comments.Count
+	if r.Comments.TotalCount > 0 {
+		c.CommentCount = r.Comments.TotalCount
+	}
+
+	c.Raw = r.Raw
 
 	return c
 }
@@ -1,9 +1,169 @@
 package untappd
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/url"
 	"testing"
 )
 
+// TestClient_getCheckinsListSizes verifies that the checkins returned by
+// Client.getCheckins are derived from the items in a response, that only null
+// items are reported as ItemErrors, and that the count reported by the API is
+// passed to Client.OnListCount.
+func TestClient_getCheckinsListSizes(t *testing.T) {
+	var tests = []struct {
+		description string
+		body        string
+		ids         []int
+		count       int
+		errs        map[int]error
+	}{
+		{
+			description: "short items",
+			body:        `{"response":{"checkins":{"count":3,"items":[{"checkin_id":1}]}}}`,
+			ids:         []int{1},
+			count:       3,
+		},
+		{
+			description: "long items",
+			body:        `{"response":{"checkins":{"count":1,"items":[{"checkin_id":1},{"checkin_id":2},{"checkin_id":3}]}}}`,
+			ids:         []int{1, 2, 3},
+			count:       1,
+		},
+		{
+			description: "empty items",
+			body:        `{"response":{"checkins":{"count":2,"items":[]}}}`,
+			count:       2,
+		},
+		{
+			description: "null items",
+			body:        `{"response":{"checkins":{"count":3,"items":[null,{"checkin_id":2},null]}}}`,
+			ids:         []int{2},
+			count:       3,
+			errs: map[int]error{
+				0: ErrNullItem,
+				2: ErrNullItem,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		c, done := userCheckinsTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(tt.body))
+		})
+
+		count := -1
+		c.OnListCount = func(endpoint string, n int) {
+			count = n
+		}
+
+		checkins, _, err := c.User.Checkins("gregavola")
+		done()
+
+		if count != tt.count {
+			t.Fatalf("unexpected reported count for test %q: %d != %d", tt.description, count, tt.count)
+		}
+
+		errs := make(map[int]error)
+		if err != nil {
+			ierrs, ok := err.(ItemErrors)
+			if !ok {
+				t.Fatalf("unexpected error for test %q: %v", tt.description, err)
+			}
+
+			for _, e := range ierrs {
+				errs[e.Index] = e.Err
+			}
+		}
+		if len(errs) != len(tt.errs) {
+			t.Fatalf("unexpected ItemErrors for test %q: %v", tt.description, err)
+		}
+		for i, e := range tt.errs {
+			if errs[i] != e {
+				t.Fatalf("unexpected ItemError %d for test %q: %v != %v", i, tt.description, errs[i], e)
+			}
+		}
+
+		if len(checkins) != len(tt.ids) {
+			t.Fatalf("unexpected number of checkins for test %q: %d != %d",
+				tt.description, len(checkins), len(tt.ids))
+		}
+		for i, id := range tt.ids {
+			if checkins[i] == nil || checkins[i].ID != id {
+				t.Fatalf("unexpected checkin %d for test %q: %v", i, tt.description, checkins[i])
+			}
+		}
+	}
+}
+
+// Test_rawCheckinExportListSizes verifies that the lists on an exported
+// Checkin are derived from the items in a response, and that the counts
+// reported by the API are exported separately.
+func Test_rawCheckinExportListSizes(t *testing.T) {
+	var tests = []struct {
+		description string
+		body        string
+		toasts      int
+		toastCount  int
+		badges      int
+		badgeCount  int
+	}{
+		{
+			description: "short items",
+			body:        `{"toasts":{"count":1,"total_count":5,"items":[{"like_id":1}]},"badges":{"count":2,"items":[{"badge_id":1}]}}`,
+			toasts:      1,
+			toastCount:  5,
+			badges:      1,
+			badgeCount:  2,
+		},
+		{
+			description: "long items",
+			body:        `{"toasts":{"count":1,"items":[{"like_id":1},{"like_id":2}]},"badges":{"count":0,"items":[{"badge_id":1},{"badge_id":2}]}}`,
+			toasts:      2,
+			toastCount:  1,
+			badges:      2,
+			badgeCount:  0,
+		},
+		{
+			description: "empty items",
+			body:        `{"toasts":{"count":3,"total_count":3,"items":[]},"badges":{"count":1,"items":[null]}}`,
+			toasts:      0,
+			toastCount:  3,
+			badges:      0,
+			badgeCount:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		var r rawCheckin
+		if err := json.Unmarshal([]byte(tt.body), &r); err != nil {
+			t.Fatal(err)
+		}
+
+		c := r.export()
+
+		if l := len(c.Toasts); l != tt.toasts {
+			t.Fatalf("unexpected number of toasts for test %q: %d != %d", tt.description, l, tt.toasts)
+		}
+		for i, toast := range c.Toasts {
+			if toast == nil {
+				t.Fatalf("unexpected nil toast %d for test %q", i, tt.description)
+			}
+		}
+		if n := c.ToastCount; n != tt.toastCount {
+			t.Fatalf("unexpected ToastCount for test %q: %d != %d", tt.description, n, tt.toastCount)
+		}
+
+		if l := len(c.Badges); l != tt.badges {
+			t.Fatalf("unexpected number of badges for test %q: %d != %d", tt.description, l, tt.badges)
+		}
+		if n := c.BadgeCount; n != tt.badgeCount {
+			t.Fatalf("unexpected BadgeCount for test %q: %d != %d", tt.description, n, tt.badgeCount)
+		}
+	}
+}
+
 // assertExpectedCheckins validates a set of mock checkins from a test function
 // against the expected checkin JSON used when testing this package.
 func assertExpectedCheckins(t *testing.T, checkins []*Checkin) {
@@ -37,6 +197,24 @@ func assertExpectedCheckins(t *testing.T, checkins []*Checkin) {
 					UserName: "gregavola",
 				},
 			}},
+			ServingStyle: ServingDraft,
+			PurchaseVenue: &Venue{
+				Name: "Bierkraft",
+			},
+			Source: CheckinSource{
+				AppName:    "Untappd for iPhone - (V2)",
+				AppWebsite: "http://untpd.it/iphoneapp",
+			},
+			Media: []*CheckinMedia{{
+				ID: 24739918,
+				Images: PhotoImages{
+					LargeImage: url.URL{
+						Scheme: "https",
+						Host:   "untappd.akamaized.net",
+						Path:   "/photo/2014_12_13/bowl_640x640.jpg",
+					},
+				},
+			}},
 		},
 	}
 
@@ -77,12 +255,28 @@ func assertExpectedCheckins(t *testing.T, checkins []*Checkin) {
 		if checkins[i].Comments[0].User.UserName != expected[i].Comments[0].User.UserName {
 			t.Fatalf("unexpected checkin Toast.User.UserName: %q != %q", checkins[i].Comments[0].User.UserName, expected[i].Comments[0].User.UserName)
 		}
+		if checkins[i].ServingStyle != expected[i].ServingStyle {
+			t.Fatalf("unexpected checkin ServingStyle: %q != %q", checkins[i].ServingStyle, expected[i].ServingStyle)
+		}
+		if checkins[i].PurchaseVenue.Name != expected[i].PurchaseVenue.Name {
+			t.Fatalf("unexpected checkin PurchaseVenue.Name: %q != %q", checkins[i].PurchaseVenue.Name, expected[i].PurchaseVenue.Name)
+		}
+		if checkins[i].Source != expected[i].Source {
+			t.Fatalf("unexpected checkin Source: %+v != %+v", checkins[i].Source, expected[i].Source)
+		}
+		if checkins[i].Media[0].ID != expected[i].Media[0].ID {
+			t.Fatalf("unexpected checkin Media.ID: %d != %d", checkins[i].Media[0].ID, expected[i].Media[0].ID)
+		}
+		if a, b := checkins[i].Media[0].Images.LargeImage.String(), expected[i].Media[0].Images.LargeImage.String(); a != b {
+			t.Fatalf("unexpected checkin Media.Images.LargeImage: %q != %q", a, b)
+		}
 	}
 }
 
 // Canned checkins JSON response, taken from documentation: https://untappd.com/api/docs#useractivityfeed
 // All checkin responses are in this format, and it is used throughout various
 // Checkin method tests.
+// Slight modifications made to add a serving style, purchase venue, and photo.
 var userCheckinsJSON = []byte(`{
   "meta": {
     "code": 200,
@@ -234,9 +428,24 @@ var userCheckinsJSON = []byte(`{
               }
             ]
           },
+          "serving_type": "Draft",
+          "purchase_venue": {
+            "venue_id": 9917,
+            "venue_name": "Bierkraft"
+          },
           "media": {
-            "count": 0,
-            "items": []
+            "count": 1,
+            "items": [
+              {
+                "photo_id": 24739918,
+                "photo": {
+                  "photo_img_sm": "https://untappd.akamaized.net/photo/2014_12_13/bowl_100x100.jpg",
+                  "photo_img_md": "https://untappd.akamaized.net/photo/2014_12_13/bowl_320x320.jpg",
+                  "photo_img_lg": "https://untappd.akamaized.net/photo/2014_12_13/bowl_640x640.jpg",
+                  "photo_img_og": "https://untappd.akamaized.net/photo/2014_12_13/bowl_raw.jpg"
+                }
+              }
+            ]
           },
           "source": {
             "app_name": "Untappd for iPhone - (V2)",
@@ -11,9 +11,12 @@ package untappd
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -32,6 +35,10 @@ const (
 	// untappdUserAgent is the default user agent this package will report to
 	// the Untappd APIv4.
 	untappdUserAgent = "github.com/mdlayher/untappd"
+
+	// rateLimitRemainingHeader is the header used by the Untappd APIv4 to
+	// report the number of requests remaining in a client's rate limit.
+	rateLimitRemainingHeader = "X-Ratelimit-Remaining"
 )
 
 var (
@@ -45,6 +52,10 @@ var (
 	// ErrNoClientSecret is returned when an empty Client Secret is passed
 	// to NewClient.
 	ErrNoClientSecret = errors.New("no client secret")
+
+	// ErrInvalidBaseURL is returned when a URL which is not absolute is
+	// passed to Client.SetBaseURL.
+	ErrInvalidBaseURL = errors.New("invalid base URL")
 )
 
 // Client is a HTTP client for the Untappd APIv4.  It enables access to various
@@ -52,6 +63,52 @@ var (
 type Client struct {
 	UserAgent string
 
+	// RawJSON, if enabled, retains the original JSON representation of each
+	// Beer, Brewery, User, Venue, and Checkin returned by the Client in its
+	// Raw field.  This allows access to any fields which this package does
+	// not yet export, at the cost of additional memory.
+	RawJSON bool
+
+	// OnDecodeWarning, if set, is invoked for each field in an API response
+	// which did not have the expected type, and was normalized or discarded
+	// instead of failing the entire request.
+	OnDecodeWarning func(w *DecodeWarning)
+
+	// OnListCount, if set, is invoked with the number of items reported by
+	// the API for each list response, such as the total number of results for
+	// Beer.Search.  The reported count may differ from the number of items
+	// actually returned in the list.
+	OnListCount func(endpoint string, count int)
+
+	// BatchConcurrency is the maximum number of concurrent requests made by
+	// batch methods, such as Beer.InfoMany.  If not set, 4 concurrent
+	// requests are made.
+	BatchConcurrency int
+
+	// BeforeRequest hooks are invoked in order before each request is sent,
+	// and may modify the request.  If a hook returns an error, the request
+	// is not sent, and the error is returned.
+	BeforeRequest []func(r *RequestInfo) error
+
+	// AfterResponse hooks are invoked in order after each request completes
+	// or fails.  If a hook returns an error, it is returned in place of any
+	// error from the request.
+	AfterResponse []func(r *RequestInfo, res *ResponseInfo) error
+
+	// Logger, if set, is used to log each request at debug level, along
+	// with its status, duration, and remaining rate limit.  The Client's
+	// credentials are always redacted.
+	Logger *slog.Logger
+
+	// Metrics, if set, collects metrics for each request.  NewMetrics
+	// returns a built-in implementation.
+	Metrics MetricsCollector
+
+	// Tracer, if set, is used to create a span for each service method
+	// call, as a child of any span in the context passed to methods such as
+	// Beer.InfoContext and Beer.InfoMany.
+	Tracer Tracer
+
 	client *http.Client
 	url    *url.URL
 
@@ -60,86 +117,30 @@ type Client struct {
 
 	accessToken string
 
-	// Methods which require authentication
-	Auth interface {
-		// https://untappd.com/api/docs#checkin
-		Checkin(r CheckinRequest) (*Checkin, *http.Response, error)
+	// Rate limit as last reported by the API
+	rateLimit rateLimitState
 
-		// https://untappd.com/api/docs#activityfeed
-		Checkins() ([]*Checkin, *http.Response, error)
-		CheckinsMinMaxIDLimit(minID int, maxID int, limit int) ([]*Checkin, *http.Response, error)
-	}
+	// Services which provide access to API methods.  Each may be replaced
+	// with another implementation in tests, such as a fake from package
+	// untappdtest.
 
-	// Methods involving a Beer
-	Beer interface {
-		// https://untappd.com/api/docs#beeractivityfeed
-		Checkins(id int) ([]*Checkin, *http.Response, error)
-		CheckinsMinMaxIDLimit(id int, minID int, maxID int, limit int) ([]*Checkin, *http.Response, error)
-
-		// https://untappd.com/api/docs#beerinfo
-		Info(id int, compact bool) (*Beer, *http.Response, error)
+	// Methods which require authentication
+	Auth AuthAPI
 
-		// https://untappd.com/api/docs#beersearch
-		Search(query string) ([]*Beer, *http.Response, error)
-		SearchOffsetLimitSort(query string, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error)
-	}
+	// Methods involving a Beer
+	Beer BeerAPI
 
 	// Methods involving a Brewery
-	Brewery interface {
-		// https://untappd.com/api/docs#breweryactivityfeed
-		Checkins(id int) ([]*Checkin, *http.Response, error)
-		CheckinsMinMaxIDLimit(id int, minID int, maxID int, limit int) ([]*Checkin, *http.Response, error)
-
-		// https://untappd.com/api/docs#breweryinfo
-		Info(id int, compact bool) (*Brewery, *http.Response, error)
-
-		// https://untappd.com/api/docs#brewerysearch
-		Search(query string) ([]*Brewery, *http.Response, error)
-		SearchOffsetLimit(query string, offset int, limit int) ([]*Brewery, *http.Response, error)
-	}
+	Brewery BreweryAPI
 
 	// Methods involving a Local area
-	Local interface {
-		// https://untappd.com/api/docs#theppublocal
-		Checkins(latitude float64, longitude float64) ([]*Checkin, *http.Response, error)
-		CheckinsMinMaxIDLimitRadius(r LocalCheckinsRequest) ([]*Checkin, *http.Response, error)
-	}
+	Local LocalAPI
 
 	// Methods involving a User
-	User interface {
-		// https://untappd.com/api/docs#userbadges
-		Badges(username string) ([]*Badge, *http.Response, error)
-		BadgesOffsetLimit(username string, offset int, limit int) ([]*Badge, *http.Response, error)
-
-		// https://untappd.com/api/docs#userbeers
-		Beers(username string) ([]*Beer, *http.Response, error)
-		BeersOffsetLimitSort(username string, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error)
-
-		// https://untappd.com/api/docs#useractivityfeed
-		Checkins(username string) ([]*Checkin, *http.Response, error)
-		CheckinsMinMaxIDLimit(username string, minID int, maxID int, limit int) ([]*Checkin, *http.Response, error)
-
-		// https://untappd.com/api/docs#userfriends
-		Friends(username string) ([]*User, *http.Response, error)
-		FriendsOffsetLimit(username string, offset int, limit int) ([]*User, *http.Response, error)
-
-		// https://untappd.com/api/docs#userinfo
-		Info(username string, compact bool) (*User, *http.Response, error)
-
-		// https://untappd.com/api/docs#userwishlist
-		WishList(username string) ([]*Beer, *http.Response, error)
-		WishListOffsetLimitSort(username string, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error)
-	}
+	User UserAPI
 
 	// Methods involving a Venue
-	Venue interface {
-		// https://untappd.com/api/docs#venueactivityfeed
-		Checkins(id int) ([]*Checkin, *http.Response, error)
-		CheckinsMinMaxIDLimit(id int, minID int, maxID int, limit int) ([]*Checkin, *http.Response, error)
-
-		// https://untappd.com/api/docs#venueinfo
-		Info(id int, compact bool) (*Venue, *http.Response, error)
-	}
+	Venue VenueAPI
 }
 
 // NewClient creates a properly initialized instance of Client, using the input
@@ -218,6 +219,28 @@ func newClient(clientID string, clientSecret string, accessToken string, client
 	return c, nil
 }
 
+// SetBaseURL sets the base URL used for requests to the Untappd APIv4, such
+// as "https://api.untappd.com/v4".  This is typically used to point a Client
+// at a fake API server in tests, such as the one provided by package
+// untappdtest.
+func (c *Client) SetBaseURL(rawurl string) error {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return err
+	}
+
+	// Only absolute URLs can be used as a base for API endpoints
+	if u.Scheme == "" || u.Host == "" {
+		return ErrInvalidBaseURL
+	}
+
+	// Trim trailing slash, since request adds one as needed
+	u.Path = strings.TrimSuffix(u.Path, "/")
+
+	c.url = u
+	return nil
+}
+
 // Error represents an error returned from the Untappd APIv4.
 type Error struct {
 	Code              int
@@ -243,6 +266,15 @@ func (e Error) Error() string {
 // Additionally, it accepts POST body parameters, GET query parameters, and an
 // optional struct which can be used to unmarshal result JSON.
 func (c *Client) request(method string, endpoint string, body url.Values, query url.Values, v interface{}) (*http.Response, error) {
+	return c.requestContext(context.Background(), method, endpoint, body, query, v)
+}
+
+// requestContext is like request, but uses ctx for the HTTP request.  If ctx
+// contains a span started by a service method, the request is described in it.
+func (c *Client) requestContext(ctx context.Context, method string, endpoint string, body url.Values, query url.Values, v interface{}) (*http.Response, error) {
+	// Describe the request in the service method's span, if traced
+	c.traceRequest(ctx, endpoint)
+
 	// Generate relative URL using API root and endpoint
 	rel, err := url.Parse(fmt.Sprintf("%s/%s/", c.url.Path, endpoint))
 	if err != nil {
@@ -262,7 +294,8 @@ func (c *Client) request(method string, endpoint string, body url.Values, query
 
 	// Always prefer authenticated client access, using an access token.
 	// If no token is found, fall back to unauthenticated client ID and
-	// client secret.
+	// client secret.  Credentials are redacted wherever URLs are logged
+	// or reported in errors.
 	if c.accessToken != "" {
 		q.Set("access_token", c.accessToken)
 	} else {
@@ -285,7 +318,7 @@ func (c *Client) request(method string, endpoint string, body url.Values, query
 	}
 
 	// Generate new HTTP request for appropriate URL
-	req, err := http.NewRequest(method, u.String(), buf)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
 	if err != nil {
 		return nil, err
 	}
@@ -302,54 +335,145 @@ func (c *Client) request(method string, endpoint string, body url.Values, query
 	// Identify the client
 	req.Header.Add("User-Agent", c.UserAgent)
 
+	// Allow hooks to inspect or modify the request, or abort it entirely
+	info := &RequestInfo{
+		Endpoint: endpoint,
+		Method:   method,
+		Params:   redactParams(q, body),
+		Request:  req,
+	}
+	if err := c.beforeRequest(info); err != nil {
+		return nil, err
+	}
+
+	// Perform the request, logging and collecting metrics for its outcome
+	// if requested
+	start := time.Now()
+	res, meta, err := c.do(info, start, v)
+	d := time.Since(start)
+	c.logRequest(ctx, info, res, meta, d, err)
+	c.observeRequest(info, res, d, err)
+
+	return res, err
+}
+
+// do performs the request described by info, started at start, and decodes
+// the response body into v.  If the request will be logged, the metadata of
+// the response body is also decoded and returned.
+func (c *Client) do(info *RequestInfo, start time.Time, v interface{}) (*http.Response, *responseMeta, error) {
 	// Invoke request using underlying HTTP client
-	res, err := c.client.Do(req)
+	// Errors from the HTTP client contain the request URL, so any secrets
+	// must be redacted
+	res, err := c.client.Do(info.Request)
 	if err != nil {
-		return nil, err
+		return nil, nil, c.afterResponse(info, nil, start, redactError(err))
 	}
 	defer res.Body.Close()
 
-	// Check response for errors
-	if err := checkResponse(res); err != nil {
-		return res, err
+	// Keep track of the remaining rate limit, for use with batch methods
+	c.rateLimit.update(res)
+
+	// Check response for errors, allowing hooks to observe the outcome
+	if err := c.afterResponse(info, res, start, checkResponse(res)); err != nil {
+		return res, nil, err
 	}
 
 	// If no second parameter was passed, do not attempt to handle response
 	if v == nil {
-		return res, nil
+		return res, nil, nil
+	}
+
+	// Read the entire body, so that it may be decoded again if needed
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return res, nil, err
+	}
+
+	// Decode response body into v, along with its metadata only if it
+	// is needed for logging
+	var meta *responseMeta
+	if c.debugEnabled(info.Request.Context()) {
+		var m struct {
+			Meta *responseMeta `json:"meta"`
+		}
+		if json.Unmarshal(b, &m) == nil {
+			meta = m.Meta
+		}
+	}
+
+	if err := c.decode(info.Endpoint, "", b, v); err != nil {
+		return res, meta, err
+	}
+
+	return res, meta, nil
+}
+
+// Do performs a HTTP request against an arbitrary Untappd APIv4 endpoint, such
+// as "beer/info/1", using the specified HTTP method and parameters, and
+// unmarshals the result JSON into v, if v is not nil.  For POST requests,
+// params are sent as the request body; otherwise, they are sent as query
+// parameters.  The Client's credentials are always added to the request.
+//
+// Do is intended as an escape hatch for API endpoints which this package does
+// not yet wrap.  Where possible, the methods provided by each service should
+// be preferred.
+func (c *Client) Do(method string, endpoint string, params url.Values, v interface{}) (*http.Response, error) {
+	return c.DoContext(context.Background(), method, endpoint, params, v)
+}
+
+// DoContext is like Do, but uses ctx for the request.
+func (c *Client) DoContext(ctx context.Context, method string, endpoint string, params url.Values, v interface{}) (res *http.Response, err error) {
+	ctx, span := c.startSpan(ctx, "untappd.Client.Do")
+	defer func() {
+		endSpan(span, res, err)
+	}()
+
+	// Trim slashes, since requestContext adds them as needed
+	endpoint = strings.Trim(endpoint, "/")
+
+	if method == "POST" {
+		return c.requestContext(ctx, method, endpoint, params, nil, v)
 	}
 
-	// Decode response body into v, returning response
-	return res, json.NewDecoder(res.Body).Decode(v)
+	return c.requestContext(ctx, method, endpoint, nil, params, v)
 }
 
 // getCheckins is the backing method for both any request which returns a
 // list of checkins.  It handles performing the necessary HTTP request
-// with the correct parameters, and returns a list of Checkins.
-func (c *Client) getCheckins(endpoint string, q url.Values) ([]*Checkin, *http.Response, error) {
-	// Temporary struct to unmarshal checkin JSON
+// with the correct parameters, and returns a list of Checkins.  If any
+// checkins cannot be decoded, the remaining Checkins are returned along
+// with ItemErrors.
+func (c *Client) getCheckins(ctx context.Context, endpoint string, q url.Values) ([]*Checkin, *http.Response, error) {
+	// Temporary struct to unmarshal checkin JSON.  Items are decoded
+	// individually, so that one bad item does not fail the entire list.
 	var v struct {
 		Response struct {
 			Checkins struct {
-				Count int           `json:"count"`
-				Items []*rawCheckin `json:"items"`
+				Count int               `json:"count"`
+				Items []json.RawMessage `json:"items"`
 			} `json:"checkins"`
 		} `json:"response"`
 	}
 
 	// Perform request for user checkins by ID
-	res, err := c.request("GET", endpoint, nil, q, &v)
+	res, err := c.requestContext(ctx, "GET", endpoint, nil, q, &v)
 	if err != nil {
 		return nil, res, err
 	}
 
 	// Build result slice from struct
-	checkins := make([]*Checkin, v.Response.Checkins.Count)
-	for i := range v.Response.Checkins.Items {
-		checkins[i] = v.Response.Checkins.Items[i].export()
-	}
+	var checkins []*Checkin
+	err = c.decodeItems(endpoint, "response.checkins.items", v.Response.Checkins.Count, v.Response.Checkins.Items, func(decode func(v interface{}) error) error {
+		var item rawCheckin
+		if err := decode(&item); err != nil {
+			return err
+		}
+
+		checkins = append(checkins, item.export())
+		return nil
+	})
 
-	return checkins, res, nil
+	return checkins, res, err
 }
 
 // checkResponse checks for a non-200 HTTP status code, and returns any errors
@@ -52,6 +52,53 @@ func TestNewAuthenticatedClient(t *testing.T) {
 	}
 }
 
+// TestClientSetBaseURL verifies that Client.SetBaseURL only accepts absolute
+// URLs, and that requests are made relative to the new base URL.
+func TestClientSetBaseURL(t *testing.T) {
+	var tests = []struct {
+		description string
+		url         string
+		expErr      error
+	}{
+		{"relative URL", "/v4", ErrInvalidBaseURL},
+		{"no scheme", "api.untappd.com/v4", ErrInvalidBaseURL},
+		{"ok", "https://api.untappd.com/v4", nil},
+	}
+
+	for _, tt := range tests {
+		c, err := NewClient("foo", "bar", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := c.SetBaseURL(tt.url); err != tt.expErr {
+			t.Fatalf("unexpected error for test %q: %v != %v", tt.description, err, tt.expErr)
+		}
+	}
+
+	path := "/api/v4/beer/info/1/"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if p := r.URL.Path; p != path {
+			t.Fatalf("unexpected URL path: %q != %q", p, path)
+		}
+
+		w.Header().Set("Content-Type", jsonContentType)
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("foo", "bar", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetBaseURL(srv.URL + "/api/v4/"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.request("GET", "beer/info/1", nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
 // TestErrorError tests for consistent output from the Error.Error method.
 func TestErrorError(t *testing.T) {
 	var tests = []struct {
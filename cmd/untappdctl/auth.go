@@ -99,12 +99,11 @@ func authCheckinsCommand(limitFlag, minIDFlag, maxIDFlag *cli.IntFlag) *cli.Comm
 				ctx.Int("limit"),
 			)
 			printRateLimit(res)
-			if err != nil {
-				log.Fatal(err)
-			}
+			errs := listItemErrors(err)
 
 			// Print out checkins in human-readable format
 			printCheckins(checkins)
+			printItemErrors(errs)
 			return nil
 		},
 	}
@@ -151,7 +150,7 @@ func authLoginCommand() *cli.Command {
 
 			// Set up http.Handler which allows easy OAuth authentication
 			// with Untappd APIv4
-			h, clientURL, err := untappd.NewAuthHandler(
+			h, err := untappd.NewOAuthHandler(
 				ctx.String("client_id"),
 				ctx.String("client_secret"),
 				redirectURL,
@@ -174,8 +173,9 @@ func authLoginCommand() *cli.Command {
 				}
 			}()
 
-			// Provide link for user to open to start authentication flow
-			log.Println(clientURL.String())
+			// Provide link for user to open to start authentication flow,
+			// which is served by the handler at the redirect URL
+			log.Println(redirectURL)
 
 			// Block until one authentication completes
 			<-doneC
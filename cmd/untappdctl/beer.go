@@ -53,12 +53,11 @@ func beerCheckinsCommand(limitFlag, minIDFlag, maxIDFlag *cli.IntFlag) *cli.Comm
 				limit,
 			)
 			printRateLimit(res)
-			if err != nil {
-				log.Fatal(err)
-			}
+			errs := listItemErrors(err)
 
 			// Print out checkins in human-readable format
 			printCheckins(checkins)
+			printItemErrors(errs)
 			return nil
 		},
 	}
@@ -117,12 +116,11 @@ func beerSearchCommand(offsetFlag, limitFlag *cli.IntFlag, sortFlag *cli.StringF
 				sort,
 			)
 			printRateLimit(res)
-			if err != nil {
-				log.Fatal(err)
-			}
+			errs := listItemErrors(err)
 
 			// Print out beers in human-readable format
 			printBeers(beers)
+			printItemErrors(errs)
 			return nil
 		},
 	}
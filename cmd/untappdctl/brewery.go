@@ -1,6 +1,8 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"log"
 	"strconv"
 
@@ -9,13 +11,14 @@ import (
 )
 
 // breweryCommand allows access to untappd.Client.Brewery methods, such as brewery
-// information by ID, and query by search term.
-func breweryCommand(offsetFlag, limitFlag, minIDFlag, maxIDFlag *cli.IntFlag) *cli.Command {
+// information by ID, beers made by a brewery, and query by search term.
+func breweryCommand(offsetFlag, limitFlag *cli.IntFlag, sortFlag *cli.StringFlag, minIDFlag, maxIDFlag *cli.IntFlag) *cli.Command {
 	return &cli.Command{
 		Name:    "brewery",
 		Aliases: []string{"br"},
 		Usage:   "query for brewery information, by brewery ID or name",
 		Subcommands: []*cli.Command{
+			breweryBeersCommand(offsetFlag, limitFlag, sortFlag),
 			breweryCheckinsCommand(limitFlag, minIDFlag, maxIDFlag),
 			breweryInfoCommand(),
 			brewerySearchCommand(offsetFlag, limitFlag),
@@ -23,6 +26,73 @@ func breweryCommand(offsetFlag, limitFlag, minIDFlag, maxIDFlag *cli.IntFlag) *c
 	}
 }
 
+// breweryBeersCommand allows access to the untappd.Client.Brewery.Beers method, which
+// can query for information about beers made by a brewery, by ID.
+func breweryBeersCommand(offsetFlag, limitFlag *cli.IntFlag, sortFlag *cli.StringFlag) *cli.Command {
+	return &cli.Command{
+		Name:    "beers",
+		Aliases: []string{"b"},
+		Usage:   "query for beers made by a specified brewery, by ID",
+		Flags: []cli.Flag{
+			offsetFlag,
+			limitFlag,
+			sortFlag,
+			&cli.BoolFlag{
+				Name:  "all",
+				Usage: "page through and display every beer made by the brewery",
+			},
+		},
+
+		Action: func(ctx *cli.Context) error {
+			// Check for valid integer ID
+			id, err := strconv.Atoi(mustStringArg(ctx, "brewery ID"))
+			checkAtoiError(err)
+
+			offset, limit, sort := offsetLimitSort(ctx)
+
+			// Query for brewery's beers by brewery ID, e.g.
+			// "untappdctl brewery beers 1 --sort highest_rated"
+			c := untappdClient(ctx)
+
+			// Page through every beer, if requested
+			if ctx.Bool("all") {
+				var beers []*untappd.Beer
+				var errs untappd.ItemErrors
+				for b, err := range c.Brewery.AllBeers(context.Background(), id, sort) {
+					var ierr *untappd.ItemError
+					if errors.As(err, &ierr) {
+						errs = append(errs, ierr)
+						continue
+					}
+					if err != nil {
+						log.Fatal(err)
+					}
+
+					beers = append(beers, b)
+				}
+
+				printBeers(beers)
+				printItemErrors(errs)
+				return nil
+			}
+
+			beers, res, err := c.Brewery.BeersOffsetLimitSort(
+				id,
+				offset,
+				limit,
+				sort,
+			)
+			printRateLimit(res)
+			errs := listItemErrors(err)
+
+			// Print out beers in human-readable format
+			printBeers(beers)
+			printItemErrors(errs)
+			return nil
+		},
+	}
+}
+
 // breweryCheckinsCommand allows access to the untappd.Client.Brewery.Checkins method, which
 // can query for information about recent checkins for beers made by a brewery, by ID.
 func breweryCheckinsCommand(limitFlag, minIDFlag, maxIDFlag *cli.IntFlag) *cli.Command {
@@ -53,12 +123,11 @@ func breweryCheckinsCommand(limitFlag, minIDFlag, maxIDFlag *cli.IntFlag) *cli.C
 				limit,
 			)
 			printRateLimit(res)
-			if err != nil {
-				log.Fatal(err)
-			}
+			errs := listItemErrors(err)
 
 			// Print out checkins in human-readable format
 			printCheckins(checkins)
+			printItemErrors(errs)
 			return nil
 		},
 	}
@@ -116,12 +185,11 @@ func brewerySearchCommand(offsetFlag, limitFlag *cli.IntFlag) *cli.Command {
 				limit,
 			)
 			printRateLimit(res)
-			if err != nil {
-				log.Fatal(err)
-			}
+			errs := listItemErrors(err)
 
 			// Print out breweries in human-readable format
 			printBreweries(breweries)
+			printItemErrors(errs)
 			return nil
 		},
 	}
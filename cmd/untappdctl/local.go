@@ -81,12 +81,11 @@ func localCheckinsCommand(limitFlag, minIDFlag, maxIDFlag *cli.IntFlag) *cli.Com
 				Units:     unit,
 			})
 			printRateLimit(res)
-			if err != nil {
-				log.Fatal(err)
-			}
+			errs := listItemErrors(err)
 
 			// Print out checkins in human-readable format
 			printCheckins(checkins)
+			printItemErrors(errs)
 			return nil
 		},
 	}
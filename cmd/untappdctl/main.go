@@ -1,8 +1,10 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"log/slog"
 	"math"
 	"net/http"
 	"os"
@@ -48,6 +50,10 @@ func main() {
 			Usage:   "authenticated access token for Untappd APIv4",
 			EnvVars: []string{"UNTAPPD_TOKEN"},
 		},
+		&cli.BoolFlag{
+			Name:  "debug",
+			Usage: "log each request to Untappd APIv4 to stderr",
+		},
 	}
 
 	// Frequently used flags for paging and sorting results, with their
@@ -84,7 +90,7 @@ func main() {
 	app.Commands = []*cli.Command{
 		authCommand(limitFlag, minIDFlag, maxIDFlag),
 		beerCommand(offsetFlag, limitFlag, sortFlag, minIDFlag, maxIDFlag),
-		breweryCommand(offsetFlag, limitFlag, minIDFlag, maxIDFlag),
+		breweryCommand(offsetFlag, limitFlag, sortFlag, minIDFlag, maxIDFlag),
 		localCommand(limitFlag, minIDFlag, maxIDFlag),
 		userCommand(offsetFlag, limitFlag, sortFlag, minIDFlag, maxIDFlag),
 		venueCommand(limitFlag, minIDFlag, maxIDFlag),
@@ -119,6 +125,13 @@ func untappdClient(ctx *cli.Context) *untappd.Client {
 		log.Fatal(err)
 	}
 
+	// Log each request, if requested
+	if ctx.Bool("debug") {
+		c.Logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+			Level: slog.LevelDebug,
+		}))
+	}
+
 	return c
 }
 
@@ -131,6 +144,32 @@ func printRateLimit(res *http.Response) {
 	}
 }
 
+// listItemErrors is a helper method which checks the error returned by a
+// method which returns a list.  Items which could not be decoded are returned,
+// so that they can be reported after the rest of the list is printed; any
+// other error is fatal.
+func listItemErrors(err error) untappd.ItemErrors {
+	if err == nil {
+		return nil
+	}
+
+	var errs untappd.ItemErrors
+	if errors.As(err, &errs) {
+		return errs
+	}
+
+	log.Fatal(err)
+	return nil
+}
+
+// printItemErrors is a helper method which warns about each list item which
+// could not be decoded, and was omitted from the output.
+func printItemErrors(errs untappd.ItemErrors) {
+	for _, err := range errs {
+		log.Printf("warning: omitted %v", err)
+	}
+}
+
 // mustStringArg is a helper method which checks for a string argument in the
 // CLI context, and prints a help message if it is not found.
 func mustStringArg(ctx *cli.Context, name string) string {
@@ -112,11 +112,11 @@ func printCheckins(checkins []*untappd.Checkin) {
 	tw := tabWriter()
 
 	// Print field header
-	fmt.Fprintln(tw, "ID\tName\tBrewery\tRating\tBadges\tToasts\tComments\tComment")
+	fmt.Fprintln(tw, "ID\tName\tBrewery\tRating\tBadges\tToasts\tComments\tPhoto\tComment")
 
 	// Print out each checkin
 	for _, c := range checkins {
-		fmt.Fprintf(tw, "%d\t%s\t%s\t%0.2f\t%d\t%d\t%d\t%s\n",
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%0.2f\t%d\t%d\t%d\t%t\t%s\n",
 			c.ID,
 			c.Beer.Name,
 			c.Brewery.Name,
@@ -124,6 +124,7 @@ func printCheckins(checkins []*untappd.Checkin) {
 			len(c.Badges),
 			len(c.Toasts),
 			len(c.Comments),
+			len(c.Media) > 0,
 			c.Comment,
 		)
 	}
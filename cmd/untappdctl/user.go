@@ -49,12 +49,11 @@ func userBadgesCommand(offsetFlag, limitFlag *cli.IntFlag) *cli.Command {
 				limit,
 			)
 			printRateLimit(res)
-			if err != nil {
-				log.Fatal(err)
-			}
+			errs := listItemErrors(err)
 
 			// Print out badges in human-readable format
 			printBadges(badges)
+			printItemErrors(errs)
 			return nil
 		},
 	}
@@ -86,12 +85,11 @@ func userBeersCommand(offsetFlag, limitFlag *cli.IntFlag, sortFlag *cli.StringFl
 				untappd.Sort(sort),
 			)
 			printRateLimit(res)
-			if err != nil {
-				log.Fatal(err)
-			}
+			errs := listItemErrors(err)
 
 			// Print out beers in human-readable format
 			printBeers(beers)
+			printItemErrors(errs)
 			return nil
 		},
 	}
@@ -123,12 +121,11 @@ func userCheckinsCommand(limitFlag, minIDFlag, maxIDFlag *cli.IntFlag) *cli.Comm
 				limit,
 			)
 			printRateLimit(res)
-			if err != nil {
-				log.Fatal(err)
-			}
+			errs := listItemErrors(err)
 
 			// Print out checkins in human-readable format
 			printCheckins(checkins)
+			printItemErrors(errs)
 			return nil
 		},
 	}
@@ -158,12 +155,11 @@ func userFriendsCommand(offsetFlag, limitFlag *cli.IntFlag) *cli.Command {
 				limit,
 			)
 			printRateLimit(res)
-			if err != nil {
-				log.Fatal(err)
-			}
+			errs := listItemErrors(err)
 
 			// Print out users in human-readable format
 			printUsers(friends, false)
+			printItemErrors(errs)
 			return nil
 		},
 	}
@@ -219,12 +215,11 @@ func userWishListCommand(offsetFlag, limitFlag *cli.IntFlag, sortFlag *cli.Strin
 				untappd.Sort(sort),
 			)
 			printRateLimit(res)
-			if err != nil {
-				log.Fatal(err)
-			}
+			errs := listItemErrors(err)
 
 			// Print out beers in human-readable format
 			printBeers(beers)
+			printItemErrors(errs)
 			return nil
 		},
 	}
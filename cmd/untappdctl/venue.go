@@ -52,12 +52,11 @@ func venueCheckinsCommand(limitFlag, minIDFlag, maxIDFlag *cli.IntFlag) *cli.Com
 				limit,
 			)
 			printRateLimit(res)
-			if err != nil {
-				log.Fatal(err)
-			}
+			errs := listItemErrors(err)
 
 			// Print out checkins in human-readable format
 			printCheckins(checkins)
+			printItemErrors(errs)
 			return nil
 		},
 	}
@@ -35,11 +35,16 @@ type rawComment struct {
 // export creates an exported Comment from a rawComment struct, allowing for more
 // useful structures to be created for client consumption.
 func (r *rawComment) export() *Comment {
-	return &Comment{
+	c := &Comment{
 		ID:        r.ID,
 		CheckinID: r.CheckinID,
 		Comment:   r.Comment,
 		Created:   time.Time(r.Created),
-		User:      r.User.export(),
 	}
+
+	if r.User != nil {
+		c.User = r.User.export()
+	}
+
+	return c
 }
@@ -0,0 +1,398 @@
+package untappd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// unmarshalerType is the reflect.Type of json.Unmarshaler, used to determine
+// which types handle their own JSON decoding.
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// DecodeWarning describes a field in an Untappd APIv4 response which did not
+// have the expected JSON type, and was normalized or discarded so that the
+// remainder of the response could still be decoded.
+type DecodeWarning struct {
+	// The API endpoint which returned the response.
+	Endpoint string
+
+	// The path to the field in the response, such as
+	// "response.checkins.items[2].beer.rating_score".
+	Field string
+
+	// The original JSON value of the field.
+	Value string
+
+	// Why the field was normalized or discarded.
+	Reason string
+}
+
+// String returns the string representation of a DecodeWarning.
+func (w *DecodeWarning) String() string {
+	return fmt.Sprintf("%s: %s: %s (%s)", w.Endpoint, w.Field, w.Reason, w.Value)
+}
+
+// decode unmarshals the JSON response body b from endpoint into v.  If the
+// body cannot be unmarshaled as-is, the body is normalized against the type
+// of v so that values with unexpected JSON types are converted or discarded,
+// and a DecodeWarning is reported to the Client for each of them.  field is
+// the path to b within the response, and is empty for an entire response.
+//
+// If the Client's RawJSON option is enabled, the raw JSON of each of this
+// package's raw types is captured from the original body, even if it was
+// normalized.
+func (c *Client) decode(endpoint string, field string, b []byte, v interface{}) error {
+	err := json.Unmarshal(b, v)
+	if err == nil {
+		return c.captureRaw(b, v, field, nil)
+	}
+
+	// Decode into generic values, retaining numbers as-is.  If the body
+	// isn't valid JSON, there's nothing more to be done.
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+
+	var g interface{}
+	if d.Decode(&g) != nil {
+		return err
+	}
+
+	var warnings []*DecodeWarning
+	n := &normalizer{
+		warn: func(field string, value interface{}, reason string) {
+			vb, _ := json.Marshal(value)
+			warnings = append(warnings, &DecodeWarning{
+				Endpoint: endpoint,
+				Field:    field,
+				Value:    string(vb),
+				Reason:   reason,
+			})
+		},
+		dropped: make(map[string]bool),
+	}
+
+	g, ok := n.normalize(g, reflect.TypeOf(v), field)
+	if !ok {
+		return err
+	}
+
+	nb, err := json.Marshal(g)
+	if err != nil {
+		return err
+	}
+
+	// Discard anything decoded by the first attempt, and try again
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv.Elem().Set(reflect.Zero(rv.Elem().Type()))
+	}
+	if err := json.Unmarshal(nb, v); err != nil {
+		return err
+	}
+
+	if c.OnDecodeWarning != nil {
+		for _, w := range warnings {
+			c.OnDecodeWarning(w)
+		}
+	}
+
+	// Raw JSON is captured from the original body, skipping any values
+	// which were discarded so it still lines up with v
+	return c.captureRaw(b, v, field, n.dropped)
+}
+
+// captureRaw captures raw JSON from b into v, if the Client's RawJSON option
+// is enabled.
+func (c *Client) captureRaw(b []byte, v interface{}, field string, dropped map[string]bool) error {
+	if !c.RawJSON {
+		return nil
+	}
+
+	return captureRaw(b, v, field, dropped)
+}
+
+// A normalizer converts generic JSON values into the shapes expected by
+// a Go type, reporting each value it converts or discards.
+type normalizer struct {
+	warn func(field string, value interface{}, reason string)
+
+	// If not nil, the path of each discarded value is recorded in dropped.
+	dropped map[string]bool
+}
+
+// normalize converts the generic JSON value v into a value which can be
+// unmarshaled into type t.  If v cannot be converted, normalize returns false,
+// and the value should be discarded.
+func (n *normalizer) normalize(v interface{}, t reflect.Type, field string) (interface{}, bool) {
+	// Null is valid for any type, and leaves the value unchanged
+	if v == nil {
+		return nil, true
+	}
+
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	// Types which decode themselves may still contain fields which need
+	// normalization, but are otherwise only checked to ensure they can
+	// decode the value
+	if reflect.PtrTo(t).Implements(unmarshalerType) {
+		if m, ok := v.(map[string]interface{}); ok && t.Kind() == reflect.Struct {
+			v = n.normalizeStruct(m, t, field)
+		}
+
+		b, err := json.Marshal(v)
+		if err == nil {
+			err = json.Unmarshal(b, reflect.New(t).Interface())
+		}
+		if err != nil {
+			n.warn(field, v, err.Error())
+			return nil, false
+		}
+
+		return v, true
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			n.warn(field, v, "expected object")
+			return nil, false
+		}
+
+		return n.normalizeStruct(m, t, field), true
+	case reflect.Slice, reflect.Array:
+		s, ok := v.([]interface{})
+		if !ok {
+			n.warn(field, v, "expected array")
+			return nil, false
+		}
+
+		return n.normalizeSlice(s, t, field), true
+	case reflect.Map:
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			n.warn(field, v, "expected object")
+			return nil, false
+		}
+
+		return n.normalizeMap(m, t, field), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return n.normalizeInt(v, field)
+	case reflect.Float32, reflect.Float64:
+		return n.normalizeFloat(v, field)
+	case reflect.Bool:
+		return n.normalizeBool(v, field)
+	case reflect.String:
+		switch vv := v.(type) {
+		case string:
+			return vv, true
+		case json.Number:
+			n.warn(field, v, "converted number to string")
+			return vv.String(), true
+		case bool:
+			n.warn(field, v, "converted boolean to string")
+			return strconv.FormatBool(vv), true
+		}
+
+		n.warn(field, v, "expected string")
+		return nil, false
+	}
+
+	// Any other types, such as interfaces, are left unchanged
+	return v, true
+}
+
+// normalizeStruct normalizes each value in m which corresponds to a field of
+// struct type t, discarding any values which cannot be normalized.  Keys are
+// visited in sorted order, so that warnings are reported deterministically.
+func (n *normalizer) normalizeStruct(m map[string]interface{}, t reflect.Type, field string) map[string]interface{} {
+	for _, k := range sortedKeys(m) {
+		v := m[k]
+		f, ok := jsonField(t, k)
+		if !ok {
+			continue
+		}
+
+		nv, ok := n.normalize(v, f.Type, joinField(field, k))
+		if !ok {
+			n.drop(joinField(field, k))
+			delete(m, k)
+			continue
+		}
+
+		m[k] = nv
+	}
+
+	return m
+}
+
+// normalizeMap normalizes each value in m for map type t, discarding any
+// values which cannot be normalized.
+func (n *normalizer) normalizeMap(m map[string]interface{}, t reflect.Type, field string) map[string]interface{} {
+	for _, k := range sortedKeys(m) {
+		nv, ok := n.normalize(m[k], t.Elem(), joinField(field, k))
+		if !ok {
+			n.drop(joinField(field, k))
+			delete(m, k)
+			continue
+		}
+
+		m[k] = nv
+	}
+
+	return m
+}
+
+// normalizeSlice normalizes each element in s for slice or array type t.  If
+// an element cannot be normalized, it is removed, rather than being replaced
+// with a zero value which would be indistinguishable from a real element.
+func (n *normalizer) normalizeSlice(s []interface{}, t reflect.Type, field string) []interface{} {
+	out := make([]interface{}, 0, len(s))
+	for i, v := range s {
+		nv, ok := n.normalize(v, t.Elem(), indexField(field, i))
+		if !ok {
+			n.drop(indexField(field, i))
+			continue
+		}
+
+		out = append(out, nv)
+	}
+
+	return out
+}
+
+// drop records that the value at field was discarded.
+func (n *normalizer) drop(field string) {
+	if n.dropped != nil {
+		n.dropped[field] = true
+	}
+}
+
+// normalizeInt normalizes v into an integer JSON number.
+func (n *normalizer) normalizeInt(v interface{}, field string) (interface{}, bool) {
+	var s string
+	switch vv := v.(type) {
+	case json.Number:
+		s = vv.String()
+	case string:
+		s = strings.TrimSpace(vv)
+	case bool:
+		n.warn(field, v, "converted boolean to number")
+		if vv {
+			return json.Number("1"), true
+		}
+		return json.Number("0"), true
+	default:
+		n.warn(field, v, "expected number")
+		return nil, false
+	}
+
+	if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if _, ok := v.(string); ok {
+			n.warn(field, v, "converted string to number")
+		}
+		return json.Number(s), true
+	}
+
+	// Integral floating point values, such as 1.0, are truncated
+	if f, err := strconv.ParseFloat(s, 64); err == nil && f == float64(int64(f)) {
+		n.warn(field, v, "converted floating point value to integer")
+		return json.Number(strconv.FormatInt(int64(f), 10)), true
+	}
+
+	n.warn(field, v, "expected integer")
+	return nil, false
+}
+
+// normalizeFloat normalizes v into a floating point JSON number.
+func (n *normalizer) normalizeFloat(v interface{}, field string) (interface{}, bool) {
+	switch vv := v.(type) {
+	case json.Number:
+		return vv, true
+	case string:
+		s := strings.TrimSpace(vv)
+		if _, err := strconv.ParseFloat(s, 64); err == nil {
+			n.warn(field, v, "converted string to number")
+			return json.Number(s), true
+		}
+	}
+
+	n.warn(field, v, "expected number")
+	return nil, false
+}
+
+// normalizeBool normalizes v into a JSON boolean.
+func (n *normalizer) normalizeBool(v interface{}, field string) (interface{}, bool) {
+	switch vv := v.(type) {
+	case bool:
+		return vv, true
+	case json.Number, string:
+		switch fmt.Sprint(vv) {
+		case "0", "false":
+			n.warn(field, v, "converted value to boolean")
+			return false, true
+		case "1", "true":
+			n.warn(field, v, "converted value to boolean")
+			return true, true
+		}
+	}
+
+	n.warn(field, v, "expected boolean")
+	return nil, false
+}
+
+// jsonField finds the field of struct type t which corresponds to JSON object
+// key k, using the same rules as encoding/json.
+func jsonField(t reflect.Type, k string) (reflect.StructField, bool) {
+	var fold reflect.StructField
+	var folded bool
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+
+		name := f.Name
+		if tag := f.Tag.Get("json"); tag != "" {
+			if tag == "-" {
+				continue
+			}
+			if n := strings.Split(tag, ",")[0]; n != "" {
+				name = n
+			}
+		}
+
+		// Exact matches are preferred, but encoding/json also accepts
+		// case-insensitive matches
+		if name == k {
+			return f, true
+		}
+		if !folded && strings.EqualFold(name, k) {
+			fold, folded = f, true
+		}
+	}
+
+	return fold, folded
+}
+
+// joinField joins a parent field path and a child JSON object key.
+func joinField(parent string, k string) string {
+	if parent == "" {
+		return k
+	}
+
+	return parent + "." + k
+}
+
+// indexField joins a parent field path and a JSON array index.
+func indexField(parent string, i int) string {
+	return fmt.Sprintf("%s[%d]", parent, i)
+}
@@ -0,0 +1,196 @@
+package untappd
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestClientDecodeLenientOK verifies that Client.decode normalizes values with
+// unexpected JSON types, reports a DecodeWarning for each of them, and does
+// not fail the entire request.
+func TestClientDecodeLenientOK(t *testing.T) {
+	c, done := userCheckinsTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		w.Write(lenientCheckinsJSON)
+	})
+	defer done()
+
+	var warnings []*DecodeWarning
+	c.OnDecodeWarning = func(w *DecodeWarning) {
+		warnings = append(warnings, w)
+	}
+
+	checkins, _, err := c.User.Checkins("gregavola")
+
+	// The malformed second checkin cannot be normalized, and is reported
+	// separately
+	errs, ok := err.(ItemErrors)
+	if !ok {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if l := len(errs); l != 1 || errs[0].Index != 1 {
+		t.Fatalf("unexpected ItemErrors: %v", errs)
+	}
+
+	if l := len(checkins); l != 1 {
+		t.Fatalf("unexpected number of checkins: %d != %d", l, 1)
+	}
+
+	ci := checkins[0]
+	if id := ci.ID; id != 137117722 {
+		t.Fatalf("unexpected ID: %d != %d", id, 137117722)
+	}
+	if r := ci.UserRating; r != 4.5 {
+		t.Fatalf("unexpected UserRating: %v != %v", r, 4.5)
+	}
+	if c := ci.Created; !c.IsZero() {
+		t.Fatalf("unexpected Created: %v != %v", c, time.Time{})
+	}
+	if id := ci.Beer.ID; id != 0 {
+		t.Fatalf("unexpected Beer.ID: %d != %d", id, 0)
+	}
+	if n := ci.Beer.Name; n != "Pliny the Elder" {
+		t.Fatalf("unexpected Beer.Name: %q != %q", n, "Pliny the Elder")
+	}
+	if !ci.Beer.Vintage {
+		t.Fatal("expected Beer.Vintage to be true")
+	}
+	if u := ci.Beer.Label.String(); u != "" {
+		t.Fatalf("unexpected Beer.Label: %q != %q", u, "")
+	}
+	if v := ci.Venue; v != nil {
+		t.Fatalf("unexpected Venue: %v != nil", v)
+	}
+
+	var fields []string
+	for _, w := range warnings {
+		fields = append(fields, w.Field)
+
+		if e := w.Endpoint; e != "user/checkins/gregavola" {
+			t.Fatalf("unexpected warning endpoint: %q", e)
+		}
+	}
+
+	want := []string{
+		"response.checkins.items[0].beer.bid",
+		"response.checkins.items[0].checkin_id",
+		"response.checkins.items[0].rating_score",
+		"response.checkins.items[0].venue",
+	}
+	if !reflect.DeepEqual(fields, want) {
+		t.Fatalf("unexpected warning fields:\n- %v\n- %v", fields, want)
+	}
+}
+
+// TestClientDecodeBadJSON verifies that Client.decode still returns an error
+// when a response body is not valid JSON.
+func TestClientDecodeBadJSON(t *testing.T) {
+	c, done := userCheckinsTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"response":`))
+	})
+	defer done()
+
+	if _, _, err := c.User.Checkins("gregavola"); err == nil {
+		t.Fatal("expected an error, but no error returned")
+	}
+}
+
+// Test_normalizerSliceDropsElements verifies that list elements which cannot
+// be normalized are removed and reported, rather than replaced with empty
+// values.
+func Test_normalizerSliceDropsElements(t *testing.T) {
+	var fields []string
+	n := &normalizer{
+		warn: func(field string, value interface{}, reason string) {
+			fields = append(fields, field)
+		},
+	}
+
+	in := []interface{}{
+		map[string]interface{}{"badge_id": json.Number("1")},
+		"foo",
+		map[string]interface{}{"badge_id": json.Number("2")},
+	}
+
+	out, ok := n.normalize(in, reflect.TypeOf([]*rawBadge{}), "items")
+	if !ok {
+		t.Fatal("expected list to be normalized")
+	}
+
+	want := []interface{}{in[0], in[2]}
+	if !reflect.DeepEqual(out, want) {
+		t.Fatalf("unexpected list:\n- want: %v\n-  got: %v", want, out)
+	}
+	if !reflect.DeepEqual(fields, []string{"items[1]"}) {
+		t.Fatalf("unexpected warning fields: %v", fields)
+	}
+}
+
+// TestClientDecodeLenientMap verifies that values inside of JSON objects
+// decoded as maps are normalized, and that values which cannot be normalized
+// are discarded without failing the entire request.
+func TestClientDecodeLenientMap(t *testing.T) {
+	c, done := beerInfoTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"response":{"beer":{"bid":1,"rating_distribution":{"4":"2","5":"3","1":"n/a"}}}}`))
+	})
+	defer done()
+
+	var warnings []*DecodeWarning
+	c.OnDecodeWarning = func(w *DecodeWarning) {
+		warnings = append(warnings, w)
+	}
+
+	b, _, err := c.Beer.Info(1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []RatingCount{
+		{Rating: 4, Count: 2},
+		{Rating: 5, Count: 3},
+	}
+	if !reflect.DeepEqual(b.RatingDistribution, want) {
+		t.Fatalf("unexpected RatingDistribution:\n- want: %v\n-  got: %v", want, b.RatingDistribution)
+	}
+
+	var fields []string
+	for _, w := range warnings {
+		fields = append(fields, w.Field)
+	}
+
+	wantFields := []string{
+		"response.beer.rating_distribution.1",
+		"response.beer.rating_distribution.4",
+		"response.beer.rating_distribution.5",
+	}
+	if !reflect.DeepEqual(fields, wantFields) {
+		t.Fatalf("unexpected warning fields:\n- %v\n- %v", fields, wantFields)
+	}
+}
+
+// Canned JSON containing the varying JSON types returned by the API, as well
+// as values which cannot be normalized
+var lenientCheckinsJSON = []byte(`{
+  "response": {
+    "checkins": {
+      "count": 2,
+      "items": [
+        {
+          "checkin_id": "137117722",
+          "created_at": "",
+          "rating_score": "4.5",
+          "venue": "unknown",
+          "beer": {
+            "bid": "unknown",
+            "beer_name": "Pliny the Elder",
+            "beer_label": "",
+            "is_vintage": true
+          }
+        },
+        "malformed"
+      ]
+    }
+  }
+}`)
@@ -0,0 +1,96 @@
+package untappd
+
+// FlavorTag is a flavor profile tag which may be attached to a checkin by
+// the Untappd APIv4.  A set of FlavorTag constants are provided for ease
+// of use.
+type FlavorTag string
+
+// Constants that define various flavor profile tags known to the Untappd
+// APIv4.
+const (
+	// FlavorBitter describes a bitter beer.
+	FlavorBitter FlavorTag = "bitter"
+
+	// FlavorBoozy describes a beer with noticeable alcohol.
+	FlavorBoozy FlavorTag = "boozy"
+
+	// FlavorCaramel describes a beer with caramel flavors.
+	FlavorCaramel FlavorTag = "caramel"
+
+	// FlavorChocolate describes a beer with chocolate flavors.
+	FlavorChocolate FlavorTag = "chocolate"
+
+	// FlavorCitrusy describes a beer with citrus flavors.
+	FlavorCitrusy FlavorTag = "citrusy"
+
+	// FlavorCoffee describes a beer with coffee flavors.
+	FlavorCoffee FlavorTag = "coffee"
+
+	// FlavorCrisp describes a crisp beer.
+	FlavorCrisp FlavorTag = "crisp"
+
+	// FlavorDank describes a dank beer.
+	FlavorDank FlavorTag = "dank"
+
+	// FlavorFruity describes a beer with fruit flavors.
+	FlavorFruity FlavorTag = "fruity"
+
+	// FlavorFunky describes a funky beer.
+	FlavorFunky FlavorTag = "funky"
+
+	// FlavorHoppy describes a hoppy beer.
+	FlavorHoppy FlavorTag = "hoppy"
+
+	// FlavorJuicy describes a juicy beer.
+	FlavorJuicy FlavorTag = "juicy"
+
+	// FlavorMalty describes a malty beer.
+	FlavorMalty FlavorTag = "malty"
+
+	// FlavorPiney describes a beer with pine flavors.
+	FlavorPiney FlavorTag = "piney"
+
+	// FlavorRoasty describes a beer with roasted flavors.
+	FlavorRoasty FlavorTag = "roasty"
+
+	// FlavorSmooth describes a smooth beer.
+	FlavorSmooth FlavorTag = "smooth"
+
+	// FlavorSour describes a sour beer.
+	FlavorSour FlavorTag = "sour"
+
+	// FlavorSweet describes a sweet beer.
+	FlavorSweet FlavorTag = "sweet"
+
+	// FlavorTart describes a tart beer.
+	FlavorTart FlavorTag = "tart"
+
+	// FlavorVanilla describes a beer with vanilla flavors.
+	FlavorVanilla FlavorTag = "vanilla"
+)
+
+// FlavorTags returns a slice of all available FlavorTag constants.
+func FlavorTags() []FlavorTag {
+	return []FlavorTag{
+		FlavorBitter,
+		FlavorBoozy,
+		FlavorCaramel,
+		FlavorChocolate,
+		FlavorCitrusy,
+		FlavorCoffee,
+		FlavorCrisp,
+		FlavorDank,
+		FlavorFruity,
+		FlavorFunky,
+		FlavorHoppy,
+		FlavorJuicy,
+		FlavorMalty,
+		FlavorPiney,
+		FlavorRoasty,
+		FlavorSmooth,
+		FlavorSour,
+		FlavorSweet,
+		FlavorTart,
+		FlavorVanilla,
+	}
+}
@@ -0,0 +1,43 @@
+package untappd
+
+import "testing"
+
+// TestFlavorTags verifies that every FlavorTag type is present in the output
+// of FlavorTags.
+func TestFlavorTags(t *testing.T) {
+	for _, f := range []FlavorTag{
+		FlavorBitter,
+		FlavorBoozy,
+		FlavorCaramel,
+		FlavorChocolate,
+		FlavorCitrusy,
+		FlavorCoffee,
+		FlavorCrisp,
+		FlavorDank,
+		FlavorFruity,
+		FlavorFunky,
+		FlavorHoppy,
+		FlavorJuicy,
+		FlavorMalty,
+		FlavorPiney,
+		FlavorRoasty,
+		FlavorSmooth,
+		FlavorSour,
+		FlavorSweet,
+		FlavorTart,
+		FlavorVanilla,
+	} {
+		var found bool
+		for _, ff := range FlavorTags() {
+			if f == ff {
+				found = true
+				break
+			}
+		}
+		if found {
+			continue
+		}
+
+		t.Fatalf("unknown FlavorTag type: %q", f)
+	}
+}
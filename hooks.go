@@ -0,0 +1,149 @@
+package untappd
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// redacted replaces the value of each secret parameter in a RequestInfo.
+const redacted = "REDACTED"
+
+// secretParams are the request parameters which contain a Client's
+// credentials, and must never be exposed.
+var secretParams = []string{
+	"access_token",
+	"client_id",
+	"client_secret",
+}
+
+// RequestInfo describes an Untappd APIv4 request made by a Client, and is
+// provided to each of the Client's BeforeRequest and AfterResponse hooks.
+type RequestInfo struct {
+	// The API endpoint, such as "beer/info/1".
+	Endpoint string
+
+	// The HTTP method of the request.
+	Method string
+
+	// The query parameters of the request, along with any POST body
+	// parameters, with the Client's credentials redacted.
+	Params url.Values
+
+	// The HTTP request.  BeforeRequest hooks may modify it, for example to
+	// add headers.  Its URL contains the Client's credentials, so Params
+	// should be used instead wherever parameters are logged or reported.
+	Request *http.Request
+}
+
+// ResponseInfo describes the outcome of an Untappd APIv4 request made by
+// a Client, and is provided to each of the Client's AfterResponse hooks.
+type ResponseInfo struct {
+	// The HTTP response, or nil if the request could not be performed.
+	Response *http.Response
+
+	// The time taken to perform the request and check the response
+	// for errors.
+	Latency time.Duration
+
+	// The error returned by the API, if any.
+	Error *Error
+
+	// Any error which occurred while performing the request or checking
+	// the response, including Error.
+	Err error
+}
+
+// beforeRequest invokes each of the Client's BeforeRequest hooks, stopping
+// at the first error.
+func (c *Client) beforeRequest(info *RequestInfo) error {
+	for _, fn := range c.BeforeRequest {
+		if err := fn(info); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// afterResponse invokes each of the Client's AfterResponse hooks with the
+// outcome of a request started at start, and returns the error which should
+// be returned for the request: err, unless a hook returns an error instead.
+func (c *Client) afterResponse(info *RequestInfo, res *http.Response, start time.Time, err error) error {
+	if len(c.AfterResponse) == 0 {
+		return err
+	}
+
+	rinfo := newResponseInfo(res, time.Since(start), err)
+	for _, fn := range c.AfterResponse {
+		if herr := fn(info, rinfo); herr != nil {
+			err = herr
+		}
+	}
+
+	return err
+}
+
+// newResponseInfo creates a ResponseInfo for a request which took latency to
+// perform, and resulted in res and err.
+func newResponseInfo(res *http.Response, latency time.Duration, err error) *ResponseInfo {
+	info := &ResponseInfo{
+		Response: res,
+		Latency:  latency,
+		Err:      err,
+	}
+	if apiErr, ok := err.(*Error); ok {
+		info.Error = apiErr
+	}
+
+	return info
+}
+
+// redactParams returns a copy of the parameters in each of vs, merged, with
+// the value of each secret parameter redacted.
+func redactParams(vs ...url.Values) url.Values {
+	out := make(url.Values)
+	for _, v := range vs {
+		for k, vv := range v {
+			out[k] = append(out[k], vv...)
+		}
+	}
+
+	for _, k := range secretParams {
+		if _, ok := out[k]; ok {
+			out.Set(k, redacted)
+		}
+	}
+
+	return out
+}
+
+// redactURL returns u as a string, with the value of each secret query
+// parameter redacted.
+func redactURL(u *url.URL) string {
+	ru := *u
+	ru.RawQuery = redactParams(u.Query()).Encode()
+	return ru.String()
+}
+
+// redactError returns err with the value of each secret query parameter
+// redacted from its URL, if err is a *url.Error.  Other errors are returned
+// unchanged.
+func redactError(err error) error {
+	uerr, ok := err.(*url.Error)
+	if !ok {
+		return err
+	}
+
+	u, perr := url.Parse(uerr.URL)
+	if perr != nil {
+		// Drop a URL which cannot be redacted, rather than risk exposing it
+		u = &url.URL{}
+	}
+
+	return &url.Error{
+		Op:  uerr.Op,
+		URL: redactURL(u),
+		Err: uerr.Err,
+	}
+}
@@ -0,0 +1,208 @@
+package untappd
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestClientHooksOK verifies that a Client's hooks observe each request and
+// response, with secrets redacted, and that BeforeRequest hooks may modify
+// the request.
+func TestClientHooksOK(t *testing.T) {
+	c, done := beerInfoTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		if h := r.Header.Get("X-Test"); h != "foo" {
+			t.Fatalf("unexpected X-Test header: %q != %q", h, "foo")
+		}
+
+		w.Write([]byte(`{"response":{"beer":{"bid":1}}}`))
+	})
+	defer done()
+
+	var req *RequestInfo
+	c.BeforeRequest = append(c.BeforeRequest, func(r *RequestInfo) error {
+		r.Request.Header.Set("X-Test", "foo")
+		req = r
+		return nil
+	})
+
+	var res *ResponseInfo
+	c.AfterResponse = append(c.AfterResponse, func(r *RequestInfo, rr *ResponseInfo) error {
+		if r != req {
+			t.Fatal("unexpected RequestInfo passed to AfterResponse hook")
+		}
+
+		res = rr
+		return nil
+	})
+
+	if _, _, err := c.Beer.Info(1, true); err != nil {
+		t.Fatal(err)
+	}
+
+	if req.Endpoint != "beer/info/1" || req.Method != "GET" {
+		t.Fatalf("unexpected request: %s %s", req.Method, req.Endpoint)
+	}
+
+	expected := url.Values{
+		"client_id":     []string{redacted},
+		"client_secret": []string{redacted},
+		"compact":       []string{"true"},
+	}
+	if !reflect.DeepEqual(req.Params, expected) {
+		t.Fatalf("unexpected parameters:\n- want: %v\n-  got: %v", expected, req.Params)
+	}
+
+	if res.Response == nil || res.Response.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected response: %v", res.Response)
+	}
+	if res.Err != nil || res.Error != nil {
+		t.Fatalf("unexpected errors: %v, %v", res.Err, res.Error)
+	}
+	if res.Latency <= 0 {
+		t.Fatalf("unexpected latency: %v", res.Latency)
+	}
+}
+
+// TestClientHooksAPIError verifies that AfterResponse hooks observe an error
+// returned by the API.
+func TestClientHooksAPIError(t *testing.T) {
+	c, done := beerInfoTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(invalidBeerErrJSON)
+	})
+	defer done()
+
+	var res *ResponseInfo
+	c.AfterResponse = append(c.AfterResponse, func(r *RequestInfo, rr *ResponseInfo) error {
+		res = rr
+		return nil
+	})
+
+	_, _, err := c.Beer.Info(-1, false)
+	assertInvalidBeerErr(t, err)
+
+	if res.Error == nil || res.Error.Type != "invalid_param" {
+		t.Fatalf("unexpected Error: %v", res.Error)
+	}
+	if res.Err != err {
+		t.Fatalf("unexpected Err: %v != %v", res.Err, err)
+	}
+}
+
+// TestClientHooksInjectErrors verifies that hooks may abort a request, or
+// replace its result with an error.
+func TestClientHooksInjectErrors(t *testing.T) {
+	var requests int
+	c, done := beerInfoTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"response":{"beer":{"bid":1}}}`))
+	})
+	defer done()
+
+	errBefore := errors.New("before")
+	c.BeforeRequest = []func(r *RequestInfo) error{
+		func(r *RequestInfo) error { return errBefore },
+	}
+
+	if _, _, err := c.Beer.Info(1, false); err != errBefore {
+		t.Fatalf("unexpected error: %v != %v", err, errBefore)
+	}
+	if requests != 0 {
+		t.Fatalf("unexpected number of requests: %d != %d", requests, 0)
+	}
+
+	errAfter := errors.New("after")
+	c.BeforeRequest = nil
+	c.AfterResponse = []func(r *RequestInfo, res *ResponseInfo) error{
+		func(r *RequestInfo, res *ResponseInfo) error { return errAfter },
+	}
+
+	if _, _, err := c.Beer.Info(1, false); err != errAfter {
+		t.Fatalf("unexpected error: %v != %v", err, errAfter)
+	}
+	if requests != 1 {
+		t.Fatalf("unexpected number of requests: %d != %d", requests, 1)
+	}
+}
+
+// TestClientErrorsRedactSecrets verifies that errors returned by a Client
+// never contain its credentials, for both authenticated and unauthenticated
+// Clients.
+func TestClientErrorsRedactSecrets(t *testing.T) {
+	errTransport := errors.New("transport failure")
+	hc := &http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			return nil, errTransport
+		}),
+	}
+
+	const (
+		clientID     = "secret-client-id"
+		clientSecret = "secret-client-secret"
+		accessToken  = "secret-access-token"
+	)
+
+	c, err := NewClient(clientID, clientSecret, hc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ac, err := NewAuthenticatedClient(accessToken, hc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []*Client{c, ac} {
+		var hookErr error
+		c.AfterResponse = append(c.AfterResponse, func(r *RequestInfo, res *ResponseInfo) error {
+			hookErr = res.Err
+			return nil
+		})
+
+		_, _, err := c.Beer.Info(1, false)
+		if !errors.Is(err, errTransport) {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for _, e := range []error{err, hookErr} {
+			for _, secret := range []string{clientID, clientSecret, accessToken} {
+				if strings.Contains(e.Error(), secret) {
+					t.Fatalf("error contains secret %q: %v", secret, e)
+				}
+			}
+		}
+	}
+}
+
+// Test_redactError verifies that redactError redacts secrets from the URL
+// of a *url.Error, and leaves other errors unchanged.
+func Test_redactError(t *testing.T) {
+	errFoo := errors.New("foo")
+	if err := redactError(errFoo); err != errFoo {
+		t.Fatalf("unexpected error: %v != %v", err, errFoo)
+	}
+
+	err := redactError(&url.Error{
+		Op:  "Get",
+		URL: "https://api.untappd.com/v4/beer/info/1/?client_id=foo&client_secret=bar&compact=true",
+		Err: errFoo,
+	})
+
+	expected := `Get "https://api.untappd.com/v4/beer/info/1/?client_id=REDACTED&client_secret=REDACTED&compact=true": foo`
+	if err.Error() != expected {
+		t.Fatalf("unexpected error string:\n- want: %s\n-  got: %s", expected, err.Error())
+	}
+	if !errors.Is(err, errFoo) {
+		t.Fatalf("redacted error does not wrap %v", errFoo)
+	}
+}
+
+// roundTripperFunc is a http.RoundTripper implemented by a function.
+type roundTripperFunc func(r *http.Request) (*http.Response, error)
+
+func (fn roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return fn(r)
+}
@@ -0,0 +1,87 @@
+package untappd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNullItem is the error reported in an ItemError for an item which was
+// null in a list response.
+var ErrNullItem = errors.New("null item")
+
+// ItemError describes an item in a list response from the Untappd APIv4 which
+// could not be decoded.
+type ItemError struct {
+	// The index of the item in the list.
+	Index int
+
+	// The error which occurred while decoding the item.
+	Err error
+}
+
+// Error returns the string representation of an ItemError.
+func (e *ItemError) Error() string {
+	return fmt.Sprintf("item %d: %v", e.Index, e.Err)
+}
+
+// ItemErrors is returned by methods which return a list, when one or more
+// items in the list could not be decoded or were null.  When ItemErrors is returned, the list contains every
+// item which was decoded successfully, in order.
+type ItemErrors []*ItemError
+
+// Error returns the string representation of ItemErrors.
+func (e ItemErrors) Error() string {
+	s := make([]string, 0, len(e))
+	for _, err := range e {
+		s = append(s, err.Error())
+	}
+
+	return fmt.Sprintf("%d list items could not be decoded: %s", len(e), strings.Join(s, "; "))
+}
+
+// decodeItems decodes each item of a list response from endpoint in turn.
+// For each item, fn is invoked with a decode function which decodes the
+// item into a value, so that fn may export and collect it.  field is the path
+// to the list in the response, used when reporting DecodeWarnings.  count is
+// the number of items reported by the API for the list, and is passed to the
+// Client's OnListCount hook, since it may not match the number of items.
+//
+// If any items cannot be decoded, decodeItems continues with the remaining
+// items, and returns ItemErrors describing the failures.  Null items are also
+// reported as ItemErrors.
+func (c *Client) decodeItems(endpoint string, field string, count int, items []json.RawMessage, fn func(decode func(v interface{}) error) error) error {
+	if c.OnListCount != nil {
+		c.OnListCount(endpoint, count)
+	}
+
+	var errs ItemErrors
+	for i, raw := range items {
+		if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+			errs = append(errs, &ItemError{
+				Index: i,
+				Err:   ErrNullItem,
+			})
+			continue
+		}
+
+		decode := func(v interface{}) error {
+			return c.decode(endpoint, fmt.Sprintf("%s[%d]", field, i), raw, v)
+		}
+
+		if err := fn(decode); err != nil {
+			errs = append(errs, &ItemError{
+				Index: i,
+				Err:   err,
+			})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
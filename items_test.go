@@ -0,0 +1,53 @@
+package untappd
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+// TestItemErrorsError verifies that ItemErrors describes each item which
+// could not be decoded.
+func TestItemErrorsError(t *testing.T) {
+	err := ItemErrors{
+		{Index: 1, Err: errors.New("foo")},
+		{Index: 3, Err: errors.New("bar")},
+	}
+
+	want := "2 list items could not be decoded: item 1: foo; item 3: bar"
+	if got := err.Error(); got != want {
+		t.Fatalf("unexpected error string: %q != %q", got, want)
+	}
+}
+
+// TestClientUserBadgesPartialOK verifies that a list method returns every
+// item which could be decoded, along with ItemErrors for the items which
+// could not.
+func TestClientUserBadgesPartialOK(t *testing.T) {
+	c, done := userBadgesTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"response":{"count":3,"items":[{"badge_id":1},"malformed",{"badge_id":3}]}}`))
+	})
+	defer done()
+
+	badges, _, err := c.User.Badges("gregavola")
+
+	errs, ok := err.(ItemErrors)
+	if !ok {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if l := len(errs); l != 1 {
+		t.Fatalf("unexpected number of ItemErrors: %d != %d", l, 1)
+	}
+	if i := errs[0].Index; i != 1 {
+		t.Fatalf("unexpected ItemError index: %d != %d", i, 1)
+	}
+
+	if l := len(badges); l != 2 {
+		t.Fatalf("unexpected number of badges: %d != %d", l, 2)
+	}
+	for i, id := range []int{1, 3} {
+		if badges[i].ID != id {
+			t.Fatalf("unexpected badge %d ID: %d != %d", i, badges[i].ID, id)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package untappd
 
 import (
+	"context"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -37,7 +39,12 @@ type LocalCheckinsRequest struct {
 // For more granular control, and to page through the checkins list using ID
 // parameters, use CheckinsMinMaxIDLimitRadius instead.
 func (l *LocalService) Checkins(latitude float64, longitude float64) ([]*Checkin, *http.Response, error) {
-	return l.CheckinsMinMaxIDLimitRadius(LocalCheckinsRequest{
+	return l.CheckinsContext(context.Background(), latitude, longitude)
+}
+
+// CheckinsContext is like Checkins, but uses ctx for the request.
+func (l *LocalService) CheckinsContext(ctx context.Context, latitude float64, longitude float64) ([]*Checkin, *http.Response, error) {
+	return l.CheckinsMinMaxIDLimitRadiusContext(ctx, LocalCheckinsRequest{
 		Latitude:  latitude,
 		Longitude: longitude,
 
@@ -56,6 +63,19 @@ func (l *LocalService) Checkins(latitude float64, longitude float64) ([]*Checkin
 // 25 checkins is the maximum number of checkins which may be returned by
 // one call.
 func (l *LocalService) CheckinsMinMaxIDLimitRadius(r LocalCheckinsRequest) ([]*Checkin, *http.Response, error) {
+	return l.CheckinsMinMaxIDLimitRadiusContext(context.Background(), r)
+}
+
+// CheckinsMinMaxIDLimitRadiusContext is like CheckinsMinMaxIDLimitRadius, but
+// uses ctx for the request.
+func (l *LocalService) CheckinsMinMaxIDLimitRadiusContext(ctx context.Context, r LocalCheckinsRequest) ([]*Checkin, *http.Response, error) {
+	return traceCall(ctx, l.client, "untappd.Local.Checkins", func(ctx context.Context) ([]*Checkin, *http.Response, error) {
+		return l.checkinsMinMaxIDLimitRadius(ctx, r)
+	})
+}
+
+// checkinsMinMaxIDLimitRadius implements CheckinsMinMaxIDLimitRadiusContext.
+func (l *LocalService) checkinsMinMaxIDLimitRadius(ctx context.Context, r LocalCheckinsRequest) ([]*Checkin, *http.Response, error) {
 	// Add required parameters
 	q := url.Values{
 		"lat": []string{formatFloat(r.Latitude)},
@@ -81,5 +101,27 @@ func (l *LocalService) CheckinsMinMaxIDLimitRadius(r LocalCheckinsRequest) ([]*C
 		q.Set("dist_pref", string(r.Units))
 	}
 
-	return l.client.getCheckins("thepub/local", q)
+	return l.client.getCheckins(ctx, "thepub/local", q)
+}
+
+// AllCheckins returns an iterator over all checkins in a local area, newest
+// first, paging through the checkins list using CheckinsMinMaxIDLimitRadius.
+// If r.MaxID is set, iteration begins at that checkin.  If r.Limit is not
+// set, 25 checkins are requested with each call.
+//
+// One API call is made for every page of checkins, and no further calls are
+// made once iteration stops.  Iteration ends after yielding a request error,
+// or if ctx is canceled.
+func (l *LocalService) AllCheckins(ctx context.Context, r LocalCheckinsRequest) iter.Seq2[*Checkin, error] {
+	if r.Limit == 0 {
+		r.Limit = 25
+	}
+
+	return checkinPages(ctx, l.client, "untappd.Local.AllCheckins", r.MaxID, r.Limit, func(ctx context.Context, maxID int, limit int) ([]*Checkin, error) {
+		r.MaxID = maxID
+		r.Limit = limit
+
+		checkins, _, err := l.CheckinsMinMaxIDLimitRadiusContext(ctx, r)
+		return checkins, err
+	})
 }
@@ -0,0 +1,68 @@
+package untappd
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// responseMeta is the metadata of a successful Untappd APIv4 response.
+type responseMeta struct {
+	ResponseTime *responseDuration `json:"response_time"`
+}
+
+// debugEnabled reports whether the Client logs requests made with ctx.
+func (c *Client) debugEnabled(ctx context.Context) bool {
+	return c.Logger != nil && c.Logger.Enabled(ctx, slog.LevelDebug)
+}
+
+// logRequest logs the outcome of a request at debug level, if the Client has
+// a Logger.  ctx is the context of the request, so that handlers may add
+// values from it, such as trace IDs.  meta is the metadata of the response
+// body, if it was decoded.
+func (c *Client) logRequest(ctx context.Context, info *RequestInfo, res *http.Response, meta *responseMeta, d time.Duration, err error) {
+	if !c.debugEnabled(ctx) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("endpoint", info.Endpoint),
+		slog.String("method", info.Method),
+		slog.String("url", redactURL(info.Request.URL)),
+		slog.Duration("duration", d),
+	}
+
+	if res != nil {
+		attrs = append(attrs, slog.Int("status", res.StatusCode))
+
+		if n, err := strconv.Atoi(res.Header.Get(rateLimitRemainingHeader)); err == nil {
+			attrs = append(attrs, slog.Int("rate_limit_remaining", n))
+		}
+	}
+
+	if rt, ok := apiResponseTime(meta, err); ok {
+		attrs = append(attrs, slog.Duration("response_time", rt))
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+
+	c.Logger.LogAttrs(ctx, slog.LevelDebug, "untappd request", attrs...)
+}
+
+// apiResponseTime returns the time taken by the Untappd APIv4 to process a
+// request, as reported in an Error or in the metadata of a response body.
+func apiResponseTime(meta *responseMeta, err error) (time.Duration, bool) {
+	if apiErr, ok := err.(*Error); ok {
+		return apiErr.Duration, true
+	}
+
+	if meta == nil || meta.ResponseTime == nil {
+		return 0, false
+	}
+
+	return time.Duration(*meta.ResponseTime), true
+}
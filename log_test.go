@@ -0,0 +1,160 @@
+package untappd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// TestClientLoggerOK verifies that a Client logs each request at debug level,
+// without its credentials.
+func TestClientLoggerOK(t *testing.T) {
+	c, done := beerInfoTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(rateLimitRemainingHeader, "99")
+		w.Write([]byte(`{"meta":{"code":200,"response_time":{"time":0.5,"measure":"seconds"}},"response":{"beer":{"bid":1}}}`))
+	})
+	defer done()
+
+	entry := testLogger(t, c, func() {
+		if _, _, err := c.Beer.Info(1, true); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected := map[string]interface{}{
+		"level":                "DEBUG",
+		"msg":                  "untappd request",
+		"endpoint":             "beer/info/1",
+		"method":               "GET",
+		"status":               float64(http.StatusOK),
+		"rate_limit_remaining": float64(99),
+		"response_time":        float64(500000000),
+	}
+	for k, v := range expected {
+		if entry[k] != v {
+			t.Fatalf("unexpected %q: %v != %v", k, entry[k], v)
+		}
+	}
+
+	if _, ok := entry["duration"]; !ok {
+		t.Fatal("missing duration")
+	}
+
+	u, _ := entry["url"].(string)
+	if !strings.Contains(u, "client_id="+redacted) || !strings.Contains(u, "client_secret="+redacted) {
+		t.Fatalf("unexpected URL: %q", u)
+	}
+}
+
+// TestClientLoggerAPIError verifies that a Client logs errors returned by the
+// API, along with the API's response time.
+func TestClientLoggerAPIError(t *testing.T) {
+	c, done := beerInfoTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(invalidBeerErrJSON)
+	})
+	defer done()
+
+	entry := testLogger(t, c, func() {
+		_, _, err := c.Beer.Info(-1, false)
+		assertInvalidBeerErr(t, err)
+	})
+
+	if s := entry["status"]; s != float64(http.StatusInternalServerError) {
+		t.Fatalf("unexpected status: %v", s)
+	}
+	if rt := entry["response_time"]; rt != float64(0) {
+		t.Fatalf("unexpected response time: %v", rt)
+	}
+	if e, _ := entry["error"].(string); !strings.Contains(e, "This Beer ID is invalid.") {
+		t.Fatalf("unexpected error: %q", e)
+	}
+}
+
+// TestClientLoggerLevel verifies that a Client does not log requests unless
+// debug level is enabled.
+func TestClientLoggerLevel(t *testing.T) {
+	c, done := beerInfoTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"response":{"beer":{"bid":1}}}`))
+	})
+	defer done()
+
+	var buf bytes.Buffer
+	c.Logger = slog.New(slog.NewJSONHandler(&buf, nil))
+
+	if _, _, err := c.Beer.Info(1, false); err != nil {
+		t.Fatal(err)
+	}
+
+	if buf.Len() != 0 {
+		t.Fatalf("unexpected log output: %s", buf.String())
+	}
+}
+
+// TestClientLoggerContext verifies that a Client passes the context of each
+// request to its Logger.
+func TestClientLoggerContext(t *testing.T) {
+	c, done := beerInfoTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"response":{"beer":{"bid":1}}}`))
+	})
+	defer done()
+
+	h := &contextHandler{}
+	c.Logger = slog.New(h)
+
+	ctx := context.WithValue(context.Background(), contextHandlerKey{}, "foo")
+	if _, err := c.Beer.InfoMany(ctx, []int{1}, false); err != nil {
+		t.Fatal(err)
+	}
+
+	if h.value != "foo" {
+		t.Fatalf("unexpected context value: %v != %v", h.value, "foo")
+	}
+}
+
+// contextHandlerKey is the context key recorded by contextHandler.
+type contextHandlerKey struct{}
+
+// contextHandler is a slog.Handler which records a value from the context
+// of the last record it handled.
+type contextHandler struct {
+	value interface{}
+}
+
+func (h *contextHandler) Enabled(context.Context, slog.Level) bool { return true }
+func (h *contextHandler) WithAttrs([]slog.Attr) slog.Handler       { return h }
+func (h *contextHandler) WithGroup(string) slog.Handler            { return h }
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	h.value = ctx.Value(contextHandlerKey{})
+	return nil
+}
+
+// testLogger sets a debug level JSON Logger on c, invokes fn, and returns the
+// single log entry it produced.  The entry must not contain the Client's
+// credentials.
+func testLogger(t *testing.T, c *Client, fn func()) map[string]interface{} {
+	var buf bytes.Buffer
+	c.Logger = slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+	}))
+
+	fn()
+
+	for _, secret := range []string{c.clientID, c.clientSecret} {
+		if strings.Contains(buf.String(), secret) {
+			t.Fatalf("log output contains secret %q: %s", secret, buf.String())
+		}
+	}
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("failed to decode log entry: %v: %s", err, buf.String())
+	}
+
+	return entry
+}
@@ -0,0 +1,371 @@
+package untappd
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// Exported types in this package may be marshaled to and unmarshaled from
+// JSON, so that they can be stored and served by other applications.  URLs
+// are marshaled as strings, and times are marshaled in RFC 3339 format.  Only
+// types which contain a url.URL need custom methods; all others use the
+// default encoding.
+//
+// The same types also implement the MarshalYAML and UnmarshalYAML methods
+// used by YAML packages such as gopkg.in/yaml.v3, so that URLs are marshaled
+// as strings in YAML too.  Neither method depends upon a YAML package.
+
+var (
+	// urlType and stringType are the reflect.Types of url.URL and string,
+	// used to replace URLs with strings when marshaling YAML.
+	urlType    = reflect.TypeOf(url.URL{})
+	stringType = reflect.TypeOf("")
+)
+
+// yamlType returns a struct type with the same fields as struct type t,
+// except that each url.URL or json.RawMessage field is a string.  Fields
+// which are omitted from JSON when empty are also omitted from YAML.
+func yamlType(t reflect.Type) reflect.Type {
+	fields := make([]reflect.StructField, t.NumField())
+	for i := range fields {
+		f := t.Field(i)
+
+		fields[i] = reflect.StructField{
+			Name: f.Name,
+			Type: f.Type,
+		}
+		if f.Type == urlType || f.Type == rawMessageType {
+			fields[i].Type = stringType
+		}
+		if strings.Contains(f.Tag.Get("json"), "omitempty") {
+			fields[i].Tag = `yaml:",omitempty"`
+		}
+	}
+
+	return reflect.StructOf(fields)
+}
+
+// marshalYAML returns a copy of struct v with the type returned by yamlType,
+// for use by MarshalYAML methods.
+func marshalYAML(v interface{}) (interface{}, error) {
+	rv := reflect.ValueOf(v)
+	out := reflect.New(yamlType(rv.Type())).Elem()
+
+	for i := 0; i < rv.NumField(); i++ {
+		f := rv.Field(i)
+		switch f.Type() {
+		case urlType:
+			u := f.Interface().(url.URL)
+			out.Field(i).SetString(u.String())
+		case rawMessageType:
+			out.Field(i).SetString(string(f.Bytes()))
+		default:
+			out.Field(i).Set(f)
+		}
+	}
+
+	return out.Interface(), nil
+}
+
+// unmarshalYAML uses unmarshal to unmarshal a value with the type returned by
+// yamlType, and stores it in v, a pointer to a struct, for use by UnmarshalYAML
+// methods.
+func unmarshalYAML(v interface{}, unmarshal func(interface{}) error) error {
+	rv := reflect.ValueOf(v).Elem()
+
+	in := reflect.New(yamlType(rv.Type()))
+	if err := unmarshal(in.Interface()); err != nil {
+		return err
+	}
+	in = in.Elem()
+
+	out := reflect.New(rv.Type()).Elem()
+	for i := 0; i < out.NumField(); i++ {
+		f := in.Field(i)
+		switch out.Field(i).Type() {
+		case urlType:
+			if err := parseJSONURL(out.Field(i).Addr().Interface().(*url.URL), f.String()); err != nil {
+				return err
+			}
+		case rawMessageType:
+			if s := f.String(); s != "" {
+				out.Field(i).SetBytes([]byte(s))
+			}
+		default:
+			out.Field(i).Set(f)
+		}
+	}
+
+	rv.Set(out)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m BadgeMedia) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonBadgeMedia{
+		SmallImage:  m.SmallImage.String(),
+		MediumImage: m.MediumImage.String(),
+		LargeImage:  m.LargeImage.String(),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (m *BadgeMedia) UnmarshalJSON(data []byte) error {
+	var v jsonBadgeMedia
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	var out BadgeMedia
+	for _, u := range []struct {
+		dst *url.URL
+		s   string
+	}{
+		{&out.SmallImage, v.SmallImage},
+		{&out.MediumImage, v.MediumImage},
+		{&out.LargeImage, v.LargeImage},
+	} {
+		if err := parseJSONURL(u.dst, u.s); err != nil {
+			return err
+		}
+	}
+
+	*m = out
+	return nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v3.
+func (m BadgeMedia) MarshalYAML() (interface{}, error) {
+	return marshalYAML(m)
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also accepted by gopkg.in/yaml.v3.
+func (m *BadgeMedia) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAML(m, unmarshal)
+}
+
+// jsonBadgeMedia is the JSON representation of a BadgeMedia.
+type jsonBadgeMedia struct {
+	SmallImage  string
+	MediumImage string
+	LargeImage  string
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b Beer) MarshalJSON() ([]byte, error) {
+	// Marshal using an alias type, so that this method is not called again
+	// recursively.  The Label field shadows the alias's url.URL field.
+	type alias Beer
+	return json.Marshal(struct {
+		alias
+		Label string
+	}{
+		alias: alias(b),
+		Label: b.Label.String(),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *Beer) UnmarshalJSON(data []byte) error {
+	type alias Beer
+	var v struct {
+		alias
+		Label string
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	out := Beer(v.alias)
+	if err := parseJSONURL(&out.Label, v.Label); err != nil {
+		return err
+	}
+
+	*b = out
+	return nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v3.
+func (b Beer) MarshalYAML() (interface{}, error) {
+	return marshalYAML(b)
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also accepted by gopkg.in/yaml.v3.
+func (b *Beer) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAML(b, unmarshal)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b Brewery) MarshalJSON() ([]byte, error) {
+	type alias Brewery
+	return json.Marshal(struct {
+		alias
+		Logo string
+	}{
+		alias: alias(b),
+		Logo:  b.Logo.String(),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *Brewery) UnmarshalJSON(data []byte) error {
+	type alias Brewery
+	var v struct {
+		alias
+		Logo string
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	out := Brewery(v.alias)
+	if err := parseJSONURL(&out.Logo, v.Logo); err != nil {
+		return err
+	}
+
+	*b = out
+	return nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v3.
+func (b Brewery) MarshalYAML() (interface{}, error) {
+	return marshalYAML(b)
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also accepted by gopkg.in/yaml.v3.
+func (b *Brewery) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAML(b, unmarshal)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p PhotoImages) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonPhotoImages{
+		SmallImage:    p.SmallImage.String(),
+		MediumImage:   p.MediumImage.String(),
+		LargeImage:    p.LargeImage.String(),
+		OriginalImage: p.OriginalImage.String(),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PhotoImages) UnmarshalJSON(data []byte) error {
+	var v jsonPhotoImages
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	var out PhotoImages
+	for _, u := range []struct {
+		dst *url.URL
+		s   string
+	}{
+		{&out.SmallImage, v.SmallImage},
+		{&out.MediumImage, v.MediumImage},
+		{&out.LargeImage, v.LargeImage},
+		{&out.OriginalImage, v.OriginalImage},
+	} {
+		if err := parseJSONURL(u.dst, u.s); err != nil {
+			return err
+		}
+	}
+
+	*p = out
+	return nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v3.
+func (p PhotoImages) MarshalYAML() (interface{}, error) {
+	return marshalYAML(p)
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also accepted by gopkg.in/yaml.v3.
+func (p *PhotoImages) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAML(p, unmarshal)
+}
+
+// jsonPhotoImages is the JSON representation of a PhotoImages.
+type jsonPhotoImages struct {
+	SmallImage    string
+	MediumImage   string
+	LargeImage    string
+	OriginalImage string
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u User) MarshalJSON() ([]byte, error) {
+	type alias User
+	return json.Marshal(struct {
+		alias
+		Avatar     string
+		CoverPhoto string
+		URL        string
+		UntappdURL string
+	}{
+		alias:      alias(u),
+		Avatar:     u.Avatar.String(),
+		CoverPhoto: u.CoverPhoto.String(),
+		URL:        u.URL.String(),
+		UntappdURL: u.UntappdURL.String(),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (u *User) UnmarshalJSON(data []byte) error {
+	type alias User
+	var v struct {
+		alias
+		Avatar     string
+		CoverPhoto string
+		URL        string
+		UntappdURL string
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	out := User(v.alias)
+	for _, uu := range []struct {
+		dst *url.URL
+		s   string
+	}{
+		{&out.Avatar, v.Avatar},
+		{&out.CoverPhoto, v.CoverPhoto},
+		{&out.URL, v.URL},
+		{&out.UntappdURL, v.UntappdURL},
+	} {
+		if err := parseJSONURL(uu.dst, uu.s); err != nil {
+			return err
+		}
+	}
+
+	*u = out
+	return nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v3.
+func (u User) MarshalYAML() (interface{}, error) {
+	return marshalYAML(u)
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which is also accepted by gopkg.in/yaml.v3.
+func (u *User) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAML(u, unmarshal)
+}
+
+// parseJSONURL parses a URL string from JSON or YAML into dst.
+func parseJSONURL(dst *url.URL, s string) error {
+	u, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+
+	*dst = *u
+	return nil
+}
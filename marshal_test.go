@@ -0,0 +1,258 @@
+package untappd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TestMarshalJSONRoundTrip verifies that exported types can be marshaled to
+// JSON and unmarshaled again without losing any information.
+func TestMarshalJSONRoundTrip(t *testing.T) {
+	var u struct {
+		Response struct {
+			User rawUser `json:"user"`
+		} `json:"response"`
+	}
+	var v struct {
+		Response struct {
+			Venue rawVenue `json:"venue"`
+		} `json:"response"`
+	}
+	var b struct {
+		Response struct {
+			Brewery rawBrewery `json:"brewery"`
+		} `json:"response"`
+	}
+	var c struct {
+		Response struct {
+			Checkins struct {
+				Items []*rawCheckin `json:"items"`
+			} `json:"checkins"`
+		} `json:"response"`
+	}
+	var bb struct {
+		Response struct {
+			Items []*rawBadge `json:"items"`
+		} `json:"response"`
+	}
+
+	for _, tt := range []struct {
+		body []byte
+		v    interface{}
+	}{
+		{body: gregavolaUserJSON, v: &u},
+		{body: venueJSON, v: &v},
+		{body: bellsBreweryJSON, v: &b},
+		{body: userCheckinsJSON, v: &c},
+		{body: userBadgesJSON, v: &bb},
+	} {
+		if err := json.Unmarshal(tt.body, tt.v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var tests = []struct {
+		description string
+		in          interface{}
+		out         interface{}
+	}{
+		{
+			description: "user",
+			in:          u.Response.User.export(),
+			out:         new(User),
+		},
+		{
+			description: "venue",
+			in:          v.Response.Venue.export(),
+			out:         new(Venue),
+		},
+		{
+			description: "brewery",
+			in:          b.Response.Brewery.export(),
+			out:         new(Brewery),
+		},
+		{
+			description: "checkin",
+			in:          c.Response.Checkins.Items[0].export(),
+			out:         new(Checkin),
+		},
+		{
+			description: "badge",
+			in:          bb.Response.Items[0].export(),
+			out:         new(Badge),
+		},
+	}
+
+	for _, tt := range tests {
+		first, err := json.Marshal(tt.in)
+		if err != nil {
+			t.Fatalf("failed to marshal %s: %v", tt.description, err)
+		}
+
+		if err := json.Unmarshal(first, tt.out); err != nil {
+			t.Fatalf("failed to unmarshal %s: %v", tt.description, err)
+		}
+
+		second, err := json.Marshal(tt.out)
+		if err != nil {
+			t.Fatalf("failed to marshal %s again: %v", tt.description, err)
+		}
+
+		if !bytes.Equal(first, second) {
+			t.Fatalf("unexpected JSON for %s after round trip:\n- %s\n- %s",
+				tt.description, string(first), string(second))
+		}
+
+		y, err := yaml.Marshal(tt.in)
+		if err != nil {
+			t.Fatalf("failed to marshal %s to YAML: %v", tt.description, err)
+		}
+
+		out := reflect.New(reflect.TypeOf(tt.out).Elem()).Interface()
+		if err := yaml.Unmarshal(y, out); err != nil {
+			t.Fatalf("failed to unmarshal %s from YAML: %v", tt.description, err)
+		}
+
+		third, err := json.Marshal(out)
+		if err != nil {
+			t.Fatalf("failed to marshal %s after YAML: %v", tt.description, err)
+		}
+
+		if !bytes.Equal(first, third) {
+			t.Fatalf("unexpected JSON for %s after YAML round trip:\n- %s\n- %s",
+				tt.description, string(first), string(third))
+		}
+	}
+}
+
+// TestBeerMarshalYAML verifies that a Beer's URLs are marshaled to YAML as
+// strings by gopkg.in/yaml.v3, and can be unmarshaled again.
+func TestBeerMarshalYAML(t *testing.T) {
+	label := "https://untappd.akamaized.net/site/beer_logos/beer-1.jpeg"
+	logo := "https://untappd.akamaized.net/site/brewery_logos/brewery-1.jpeg"
+
+	u, err := url.Parse(label)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lu, err := url.Parse(logo)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := &Beer{
+		ID:      1,
+		Name:    "Black Note Stout",
+		Label:   *u,
+		Style:   "123",
+		Created: time.Date(2016, time.January, 1, 22, 21, 5, 0, time.UTC),
+		Brewery: &Brewery{
+			ID:   2507,
+			Logo: *lu,
+		},
+	}
+
+	out, err := yaml.Marshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range []string{
+		"id: 1\n",
+		"name: Black Note Stout\n",
+		"label: " + label + "\n",
+		`style: "123"` + "\n",
+		"created: 2016-01-01T22:21:05Z\n",
+		"    logo: " + logo + "\n",
+	} {
+		if !strings.Contains(string(out), s) {
+			t.Fatalf("expected %q in YAML:\n%s", s, string(out))
+		}
+	}
+	if !strings.HasPrefix(string(out), "id: 1\n") {
+		t.Fatalf("expected fields in declaration order:\n%s", string(out))
+	}
+	if strings.Contains(string(out), "raw:") {
+		t.Fatalf("unexpected empty Raw in YAML:\n%s", string(out))
+	}
+
+	var bb Beer
+	if err := yaml.Unmarshal(out, &bb); err != nil {
+		t.Fatal(err)
+	}
+
+	if l := bb.Label.String(); l != label {
+		t.Fatalf("unexpected Label: %q != %q", l, label)
+	}
+	if s := bb.Style; s != "123" {
+		t.Fatalf("unexpected Style: %q != %q", s, "123")
+	}
+	if c := bb.Created; !c.Equal(b.Created) {
+		t.Fatalf("unexpected Created: %v != %v", c, b.Created)
+	}
+	if l := bb.Brewery.Logo.String(); l != logo {
+		t.Fatalf("unexpected Brewery.Logo: %q != %q", l, logo)
+	}
+}
+
+// TestBeerMarshalJSON verifies that a Beer's URLs are marshaled as strings,
+// and its times in RFC 3339 format.
+func TestBeerMarshalJSON(t *testing.T) {
+	label := "https://untappd.akamaized.net/site/beer_logos/beer-1.jpeg"
+	created := time.Date(2016, time.January, 1, 22, 21, 5, 0, time.UTC)
+
+	u, err := url.Parse(label)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := &Beer{
+		ID:      1,
+		Name:    "Black Note Stout",
+		Label:   *u,
+		Created: created,
+		Brewery: &Brewery{
+			ID: 2507,
+		},
+	}
+
+	out, err := json.Marshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range []string{
+		`"Label":"` + label + `"`,
+		`"Created":"2016-01-01T22:21:05Z"`,
+		`"Logo":""`,
+	} {
+		if !strings.Contains(string(out), s) {
+			t.Fatalf("expected %s in JSON: %s", s, string(out))
+		}
+	}
+	if strings.Contains(string(out), `"Raw"`) {
+		t.Fatalf("unexpected empty Raw in JSON: %s", string(out))
+	}
+
+	var bb Beer
+	if err := json.Unmarshal(out, &bb); err != nil {
+		t.Fatal(err)
+	}
+
+	if l := bb.Label.String(); l != label {
+		t.Fatalf("unexpected Label: %q != %q", l, label)
+	}
+	if c := bb.Created; !c.Equal(created) {
+		t.Fatalf("unexpected Created: %v != %v", c, created)
+	}
+	if id := bb.Brewery.ID; id != 2507 {
+		t.Fatalf("unexpected Brewery.ID: %d != %d", id, 2507)
+	}
+}
@@ -0,0 +1,273 @@
+package untappd
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// cacheHeader is the header set by common caching HTTP transports on
+	// responses served from cache.
+	cacheHeader = "X-From-Cache"
+
+	// metricsContentType is the content type for the Prometheus text
+	// exposition format.
+	metricsContentType = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+// defaultBuckets are the upper bounds, in seconds, of the buckets used by
+// Metrics for request duration histograms.
+var defaultBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// A MetricsCollector collects metrics for requests made by a Client.
+//
+// The Client does not retry requests or cache responses itself, so
+// ObserveRetry is provided for use by retrying layers around a Client.
+// ObserveCacheHit is invoked by the Client for responses served from cache
+// by a caching HTTP transport which sets the X-From-Cache header, and may
+// also be used by caching layers around a Client.
+type MetricsCollector interface {
+	// ObserveRequest is invoked once for each request made by a Client.
+	ObserveRequest(r *RequestInfo, res *ResponseInfo)
+
+	// ObserveRetry is invoked each time a request to endpoint is retried.
+	ObserveRetry(endpoint string)
+
+	// ObserveCacheHit is invoked each time a request to endpoint is
+	// served from cache.
+	ObserveCacheHit(endpoint string)
+}
+
+// observeRequest reports the outcome of a request to the Client's Metrics,
+// if set.
+func (c *Client) observeRequest(info *RequestInfo, res *http.Response, latency time.Duration, err error) {
+	if c.Metrics == nil {
+		return
+	}
+
+	c.Metrics.ObserveRequest(info, newResponseInfo(res, latency, err))
+
+	if res != nil && res.Header.Get(cacheHeader) != "" {
+		c.Metrics.ObserveCacheHit(info.Endpoint)
+	}
+}
+
+// Metrics is a built-in MetricsCollector, which exposes its metrics in the
+// Prometheus text exposition format using its ServeHTTP method.
+//
+// Endpoints are identified by their service and method, such as "beer/info",
+// so that IDs and usernames do not create a new series for each request.
+type Metrics struct {
+	mu sync.Mutex
+
+	requests  map[[2]string]uint64
+	durations map[string]*histogram
+	errors    map[[2]string]uint64
+	retries   map[string]uint64
+	cacheHits map[string]uint64
+
+	rateLimitKnown bool
+	rateLimit      int
+}
+
+// histogram is a cumulative histogram of request durations.
+type histogram struct {
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// NewMetrics creates a new Metrics.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		requests:  make(map[[2]string]uint64),
+		durations: make(map[string]*histogram),
+		errors:    make(map[[2]string]uint64),
+		retries:   make(map[string]uint64),
+		cacheHits: make(map[string]uint64),
+	}
+}
+
+// ObserveRequest implements MetricsCollector.
+func (m *Metrics) ObserveRequest(r *RequestInfo, res *ResponseInfo) {
+	endpoint := endpointLabel(r.Endpoint)
+
+	// Requests which could not be performed have no status code
+	status := "none"
+	if res.Response != nil {
+		status = strconv.Itoa(res.Response.StatusCode)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.requests[[2]string{endpoint, status}]++
+
+	h, ok := m.durations[endpoint]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(defaultBuckets))}
+		m.durations[endpoint] = h
+	}
+	secs := res.Latency.Seconds()
+	for i, b := range defaultBuckets {
+		if secs <= b {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += secs
+
+	if res.Err != nil {
+		m.errors[[2]string{endpoint, errorLabel(res)}]++
+	}
+
+	if res.Response != nil {
+		if n, err := strconv.Atoi(res.Response.Header.Get(rateLimitRemainingHeader)); err == nil {
+			m.rateLimitKnown = true
+			m.rateLimit = n
+		}
+	}
+}
+
+// ObserveRetry implements MetricsCollector.
+func (m *Metrics) ObserveRetry(endpoint string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.retries[endpointLabel(endpoint)]++
+}
+
+// ObserveCacheHit implements MetricsCollector.
+func (m *Metrics) ObserveCacheHit(endpoint string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.cacheHits[endpointLabel(endpoint)]++
+}
+
+// ServeHTTP implements http.Handler, and writes each metric in the
+// Prometheus text exposition format.
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", metricsContentType)
+
+	bw := bufio.NewWriter(w)
+	m.write(bw)
+	bw.Flush()
+}
+
+// write writes each metric to w in the Prometheus text exposition format.
+func (m *Metrics) write(w *bufio.Writer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	metricHeader(w, "untappd_requests_total", "counter", "Requests made to the Untappd APIv4, by endpoint and HTTP status.")
+	for _, k := range sortedKeys2(m.requests) {
+		fmt.Fprintf(w, "untappd_requests_total{endpoint=%s,status=%s} %d\n",
+			quoteLabel(k[0]), quoteLabel(k[1]), m.requests[k])
+	}
+
+	metricHeader(w, "untappd_request_duration_seconds", "histogram", "Duration of requests made to the Untappd APIv4, by endpoint.")
+	for _, e := range sortedKeys(m.durations) {
+		h := m.durations[e]
+		for i, b := range defaultBuckets {
+			fmt.Fprintf(w, "untappd_request_duration_seconds_bucket{endpoint=%s,le=%s} %d\n",
+				quoteLabel(e), quoteLabel(formatFloat(b)), h.counts[i])
+		}
+		fmt.Fprintf(w, "untappd_request_duration_seconds_bucket{endpoint=%s,le=\"+Inf\"} %d\n", quoteLabel(e), h.count)
+		fmt.Fprintf(w, "untappd_request_duration_seconds_sum{endpoint=%s} %s\n", quoteLabel(e), formatFloat(h.sum))
+		fmt.Fprintf(w, "untappd_request_duration_seconds_count{endpoint=%s} %d\n", quoteLabel(e), h.count)
+	}
+
+	metricHeader(w, "untappd_errors_total", "counter", "Errors returned by requests to the Untappd APIv4, by endpoint and error type.")
+	for _, k := range sortedKeys2(m.errors) {
+		fmt.Fprintf(w, "untappd_errors_total{endpoint=%s,type=%s} %d\n",
+			quoteLabel(k[0]), quoteLabel(k[1]), m.errors[k])
+	}
+
+	metricHeader(w, "untappd_retries_total", "counter", "Retried requests to the Untappd APIv4, by endpoint.")
+	for _, e := range sortedKeys(m.retries) {
+		fmt.Fprintf(w, "untappd_retries_total{endpoint=%s} %d\n", quoteLabel(e), m.retries[e])
+	}
+
+	metricHeader(w, "untappd_cache_hits_total", "counter", "Requests to the Untappd APIv4 served from cache, by endpoint.")
+	for _, e := range sortedKeys(m.cacheHits) {
+		fmt.Fprintf(w, "untappd_cache_hits_total{endpoint=%s} %d\n", quoteLabel(e), m.cacheHits[e])
+	}
+
+	metricHeader(w, "untappd_rate_limit_remaining", "gauge", "Requests remaining in the current Untappd APIv4 rate limit window.")
+	if m.rateLimitKnown {
+		fmt.Fprintf(w, "untappd_rate_limit_remaining %d\n", m.rateLimit)
+	}
+}
+
+// endpointLabel returns the service and method of an API endpoint, such as
+// "beer/info" for "beer/info/1".
+func endpointLabel(endpoint string) string {
+	parts := strings.SplitN(endpoint, "/", 3)
+	if len(parts) < 2 {
+		return endpoint
+	}
+
+	return parts[0] + "/" + parts[1]
+}
+
+// errorLabel returns the type of error which occurred during a request: the
+// Untappd APIv4 error type for API errors, "transport" if the request could
+// not be performed, or "response" if its response could not be handled.
+func errorLabel(res *ResponseInfo) string {
+	switch {
+	case res.Error != nil && res.Error.Type != "":
+		return res.Error.Type
+	case res.Response == nil:
+		return "transport"
+	default:
+		return "response"
+	}
+}
+
+// metricHeader writes the HELP and TYPE lines for a metric to w.
+func metricHeader(w *bufio.Writer, name string, typ string, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(w, "# TYPE %s %s\n", name, typ)
+}
+
+// quoteLabel quotes a label value, escaping it as required by the Prometheus
+// text exposition format.
+func quoteLabel(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + r.Replace(s) + `"`
+}
+
+// sortedKeys returns the keys of m, in sorted order.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// sortedKeys2 returns the keys of m, in sorted order.
+func sortedKeys2(m map[[2]string]uint64) [][2]string {
+	keys := make([][2]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i][0] != keys[j][0] {
+			return keys[i][0] < keys[j][0]
+		}
+
+		return keys[i][1] < keys[j][1]
+	})
+
+	return keys
+}
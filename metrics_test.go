@@ -0,0 +1,91 @@
+package untappd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"testing"
+)
+
+// TestMetricsOK verifies that Metrics collects metrics for each request made
+// by a Client, and exposes them in the Prometheus text exposition format.
+func TestMetricsOK(t *testing.T) {
+	c, done := beerInfoTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(rateLimitRemainingHeader, "42")
+
+		switch path.Base(path.Clean(r.URL.Path)) {
+		case "-1":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write(invalidBeerErrJSON)
+		case "2":
+			w.Header().Set(cacheHeader, "1")
+			fallthrough
+		default:
+			w.Write([]byte(`{"response":{"beer":{"bid":1}}}`))
+		}
+	})
+	defer done()
+
+	m := NewMetrics()
+	c.Metrics = m
+
+	for _, id := range []int{1, 2, -1} {
+		c.Beer.Info(id, false)
+	}
+	m.ObserveRetry("beer/info/-1")
+
+	rec := httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	if ct := rec.Header().Get("Content-Type"); ct != metricsContentType {
+		t.Fatalf("unexpected Content-Type: %q != %q", ct, metricsContentType)
+	}
+
+	body := rec.Body.String()
+	for _, line := range []string{
+		"# TYPE untappd_requests_total counter",
+		`untappd_requests_total{endpoint="beer/info",status="200"} 2`,
+		`untappd_requests_total{endpoint="beer/info",status="500"} 1`,
+		"# TYPE untappd_request_duration_seconds histogram",
+		`untappd_request_duration_seconds_bucket{endpoint="beer/info",le="+Inf"} 3`,
+		`untappd_request_duration_seconds_count{endpoint="beer/info"} 3`,
+		`untappd_errors_total{endpoint="beer/info",type="invalid_param"} 1`,
+		`untappd_retries_total{endpoint="beer/info"} 1`,
+		`untappd_cache_hits_total{endpoint="beer/info"} 1`,
+		"# TYPE untappd_rate_limit_remaining gauge",
+		"untappd_rate_limit_remaining 42",
+	} {
+		if !strings.Contains(body, line+"\n") {
+			t.Fatalf("missing line %q in metrics:\n%s", line, body)
+		}
+	}
+}
+
+// Test_endpointLabel verifies that endpointLabel removes IDs and usernames
+// from API endpoints.
+func Test_endpointLabel(t *testing.T) {
+	var tests = []struct {
+		endpoint string
+		label    string
+	}{
+		{"beer/info/1", "beer/info"},
+		{"user/friends/mdlayher", "user/friends"},
+		{"thepub/local", "thepub/local"},
+		{"user/info", "user/info"},
+		{"foo", "foo"},
+	}
+
+	for _, tt := range tests {
+		if label := endpointLabel(tt.endpoint); label != tt.label {
+			t.Fatalf("unexpected label for %q: %q != %q", tt.endpoint, label, tt.label)
+		}
+	}
+}
+
+// Test_quoteLabel verifies that quoteLabel escapes label values.
+func Test_quoteLabel(t *testing.T) {
+	if q := quoteLabel("a\\b\"c\nd"); q != `"a\\b\"c\nd"` {
+		t.Fatalf("unexpected quoted label: %s", q)
+	}
+}
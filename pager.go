@@ -0,0 +1,155 @@
+package untappd
+
+import (
+	"context"
+	"iter"
+)
+
+// offsetPages returns an iterator over each item returned by fetch, which is
+// invoked with increasing offsets until a page with less than limit items is
+// returned.  Iteration stops on the first request error, or if ctx is
+// canceled, after yielding the error.  Each iteration is traced by a span
+// named name, which is the parent of the span for each call to fetch.
+//
+// Items which could not be decoded do not stop iteration, and are yielded as
+// an *ItemError, with an index relative to the entire list.
+func offsetPages[T any](ctx context.Context, c *Client, name string, limit int, fetch func(ctx context.Context, offset int, limit int) ([]T, error)) iter.Seq2[T, error] {
+	return traceSeq(ctx, c, name, func(ctx context.Context, yield func(T, error) bool) {
+		var zero T
+		for offset := 0; ; offset += limit {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			page, err := fetch(ctx, offset, limit)
+			errs, partial := err.(ItemErrors)
+			if err != nil && !partial {
+				yield(zero, err)
+				return
+			}
+
+			for _, e := range mergePage(page, errs) {
+				if e.err != nil {
+					if !yield(zero, &ItemError{Index: offset + e.err.Index, Err: e.err.Err}) {
+						return
+					}
+					continue
+				}
+
+				if !yield(e.item, nil) {
+					return
+				}
+			}
+
+			// A short page indicates that no more items remain
+			if len(page)+len(errs) < limit {
+				return
+			}
+		}
+	})
+}
+
+// checkinPages returns an iterator over each checkin returned by fetch, which
+// is invoked with decreasing maximum checkin IDs until a page with less than
+// limit checkins is returned.  maxID is the maximum checkin ID used for
+// the first page.  Iteration stops on the first request error, or if ctx
+// is canceled, after yielding the error.  Each iteration is traced by a span
+// named name, which is the parent of the span for each call to fetch.
+//
+// Checkins which could not be decoded do not stop iteration, and are yielded
+// as an *ItemError, with an index relative to the entire list.
+func checkinPages(ctx context.Context, c *Client, name string, maxID int, limit int, fetch func(ctx context.Context, maxID int, limit int) ([]*Checkin, error)) iter.Seq2[*Checkin, error] {
+	return traceSeq(ctx, c, name, func(ctx context.Context, yield func(*Checkin, error) bool) {
+		// Each iteration begins again at maxID
+		cur, first := maxID, true
+
+		// index is the position of the next checkin or error in the entire
+		// list, and skip is the number of errors at the end of the previous
+		// page, which are expected to be fetched again
+		var index, skip int
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+
+			page, err := fetch(ctx, cur, limit)
+			errs, partial := err.(ItemErrors)
+			if err != nil && !partial {
+				yield(nil, err)
+				return
+			}
+
+			// Checkins are returned newest first, so the next page begins
+			// at the oldest checkin of this page.  Skip any checkins which
+			// were already yielded, in case the maximum ID is inclusive.
+			// Checkins which could not be decoded have no ID, but any
+			// which ended the previous page appear again before the first
+			// new checkin, so skip the same number of errors there.
+			var oldest *Checkin
+			var trailing int
+			for _, e := range mergePage(page, errs) {
+				if e.err != nil {
+					trailing++
+					if oldest == nil && skip > 0 {
+						skip--
+						continue
+					}
+
+					if !yield(nil, &ItemError{Index: index, Err: e.err.Err}) {
+						return
+					}
+					index++
+					continue
+				}
+
+				if !first && e.item.ID >= cur {
+					continue
+				}
+
+				oldest, trailing = e.item, 0
+				if !yield(e.item, nil) {
+					return
+				}
+				index++
+			}
+
+			if len(page)+len(errs) < limit || oldest == nil {
+				return
+			}
+
+			first = false
+			cur, skip = oldest.ID, trailing
+		}
+	})
+}
+
+// A pageEntry is an item in a page, or an *ItemError for an item in the page
+// which could not be decoded.
+type pageEntry[T any] struct {
+	item T
+	err  *ItemError
+}
+
+// mergePage merges the items in page with the ItemErrors for the items which
+// could not be decoded, in the order in which they appeared in the list.
+func mergePage[T any](page []T, errs ItemErrors) []pageEntry[T] {
+	entries := make([]pageEntry[T], 0, len(page)+len(errs))
+
+	// ItemErrors are ordered by index, and the items which were decoded
+	// fill each of the remaining positions in the list
+	items, rest := page, errs
+	for i := 0; len(items) > 0 || len(rest) > 0; i++ {
+		if len(rest) > 0 && (rest[0].Index == i || len(items) == 0) {
+			entries = append(entries, pageEntry[T]{err: rest[0]})
+			rest = rest[1:]
+			continue
+		}
+
+		entries = append(entries, pageEntry[T]{item: items[0]})
+		items = items[1:]
+	}
+
+	return entries
+}
@@ -0,0 +1,226 @@
+package untappd
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestClientUserAllCheckinsOK verifies that Client.User.AllCheckins pages
+// through a user's checkins using the oldest checkin ID of each page.
+func TestClientUserAllCheckinsOK(t *testing.T) {
+	var maxIDs []string
+	c, done := userCheckinsTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		maxID := r.URL.Query().Get("max_id")
+		maxIDs = append(maxIDs, maxID)
+
+		// Checkins 100 through 51 on the first page, and 51 through 41
+		// on the second, since the maximum ID may be inclusive
+		switch maxID {
+		case "":
+			w.Write(checkinsPage(100, 50))
+		case "51":
+			w.Write(checkinsPage(51, 11))
+		default:
+			t.Fatalf("unexpected max_id: %q", maxID)
+		}
+	})
+	defer done()
+
+	var ids []int
+	for c, err := range c.User.AllCheckins(context.Background(), "gregavola") {
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ids = append(ids, c.ID)
+	}
+
+	if l := len(ids); l != 60 {
+		t.Fatalf("unexpected number of checkins: %d != %d", l, 60)
+	}
+	if first, last := ids[0], ids[len(ids)-1]; first != 100 || last != 41 {
+		t.Fatalf("unexpected checkin IDs: %d..%d != %d..%d", first, last, 100, 41)
+	}
+	if s := strings.Join(maxIDs, ","); s != ",51" {
+		t.Fatalf("unexpected max_id values: %q != %q", s, ",51")
+	}
+}
+
+// TestClientUserAllCheckinsItemError verifies that Client.User.AllCheckins
+// yields an *ItemError with an index relative to the entire list for each
+// checkin which cannot be decoded, and that errors for checkins at the end
+// of a page are not yielded again when the next page begins with them.
+func TestClientUserAllCheckinsItemError(t *testing.T) {
+	c, done := userCheckinsTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		switch maxID := r.URL.Query().Get("max_id"); maxID {
+		case "":
+			// Checkins 100 through 52, followed by a malformed checkin
+			b := checkinsPage(100, 49)
+			w.Write([]byte(strings.Replace(string(b), `]}}}`, `,"malformed"]}}}`, 1)))
+		case "52":
+			w.Write([]byte(`{"response":{"checkins":{"count":7,"items":[` +
+				`{"checkin_id":52},"malformed",{"checkin_id":51},{"checkin_id":50},` +
+				`{"checkin_id":49},"malformed",{"checkin_id":48}]}}}`))
+		default:
+			t.Fatalf("unexpected max_id: %q", maxID)
+		}
+	})
+	defer done()
+
+	var n int
+	var indices []string
+	for _, err := range c.User.AllCheckins(context.Background(), "gregavola") {
+		if err != nil {
+			ie, ok := err.(*ItemError)
+			if !ok {
+				t.Fatal(err)
+			}
+
+			indices = append(indices, strconv.Itoa(ie.Index))
+			continue
+		}
+
+		n++
+	}
+
+	if n != 53 {
+		t.Fatalf("unexpected number of checkins: %d != %d", n, 53)
+	}
+	if s := strings.Join(indices, ","); s != "49,53" {
+		t.Fatalf("unexpected ItemError indices: %q != %q", s, "49,53")
+	}
+}
+
+// TestClientUserAllCheckinsReuse verifies that ranging over the iterator
+// returned by Client.User.AllCheckins more than once begins from the first
+// page each time.
+func TestClientUserAllCheckinsReuse(t *testing.T) {
+	var maxIDs []string
+	c, done := userCheckinsTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		maxID := r.URL.Query().Get("max_id")
+		maxIDs = append(maxIDs, maxID)
+
+		switch maxID {
+		case "":
+			w.Write(checkinsPage(100, 50))
+		case "51":
+			w.Write(checkinsPage(51, 11))
+		default:
+			t.Fatalf("unexpected max_id: %q", maxID)
+		}
+	})
+	defer done()
+
+	seq := c.User.AllCheckins(context.Background(), "gregavola")
+	for i := 0; i < 2; i++ {
+		var n int
+		for _, err := range seq {
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			n++
+		}
+
+		if n != 60 {
+			t.Fatalf("unexpected number of checkins on pass %d: %d != %d", i, n, 60)
+		}
+	}
+
+	if s := strings.Join(maxIDs, ","); s != ",51,,51" {
+		t.Fatalf("unexpected max_id values: %q != %q", s, ",51,,51")
+	}
+}
+
+// TestClientUserAllCheckinsBreak verifies that no further requests are made
+// once iteration over Client.User.AllCheckins stops.
+func TestClientUserAllCheckinsBreak(t *testing.T) {
+	var requests int
+	c, done := userCheckinsTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write(checkinsPage(100, 50))
+	})
+	defer done()
+
+	for _, err := range c.User.AllCheckins(context.Background(), "gregavola") {
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		break
+	}
+
+	if requests != 1 {
+		t.Fatalf("unexpected number of requests: %d != %d", requests, 1)
+	}
+}
+
+// TestClientUserAllBadgesCanceled verifies that Client.User.AllBadges makes no
+// requests and yields an error when its context is canceled.
+func TestClientUserAllBadgesCanceled(t *testing.T) {
+	c, done := userBadgesTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		t.Fatal("unexpected request with canceled context")
+	})
+	defer done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var errs []error
+	for b, err := range c.User.AllBadges(ctx, "gregavola") {
+		if b != nil {
+			t.Fatalf("unexpected badge: %v", b)
+		}
+
+		errs = append(errs, err)
+	}
+
+	if len(errs) != 1 || errs[0] != context.Canceled {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+}
+
+// TestClientUserAllBadgesItemError verifies that Client.User.AllBadges yields
+// an *ItemError for a badge which cannot be decoded at the badge's position in
+// the list, and continues iterating.
+func TestClientUserAllBadgesItemError(t *testing.T) {
+	c, done := userBadgesTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"response":{"count":4,"items":["malformed",{"badge_id":1},null,{"badge_id":3}]}}`))
+	})
+	defer done()
+
+	var got []string
+	for b, err := range c.User.AllBadges(context.Background(), "gregavola") {
+		if err != nil {
+			ie, ok := err.(*ItemError)
+			if !ok {
+				t.Fatal(err)
+			}
+
+			got = append(got, "error "+strconv.Itoa(ie.Index))
+			continue
+		}
+
+		got = append(got, "badge "+strconv.Itoa(b.ID))
+	}
+
+	want := "error 0,badge 1,error 2,badge 3"
+	if s := strings.Join(got, ","); s != want {
+		t.Fatalf("unexpected iteration order:\n- want: %s\n-  got: %s", want, s)
+	}
+}
+
+// checkinsPage generates a checkins JSON response containing n checkins,
+// with IDs descending from maxID.
+func checkinsPage(maxID int, n int) []byte {
+	items := make([]string, n)
+	for i := range items {
+		items[i] = `{"checkin_id":` + strconv.Itoa(maxID-i) + `}`
+	}
+
+	return []byte(`{"response":{"checkins":{"count":` + strconv.Itoa(n) + `,"items":[` +
+		strings.Join(items, ",") + `]}}}`)
+}
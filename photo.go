@@ -0,0 +1,100 @@
+package untappd
+
+import (
+	"net/url"
+	"time"
+)
+
+// Photo represents a photo attached to an Untappd checkin, and contains links
+// to several sizes of the photo, as well as information regarding the checkin
+// it was attached to.
+type Photo struct {
+	// Metadata from Untappd.
+	ID        int
+	CheckinID int
+
+	// Time when this photo was added to Untappd.
+	Created time.Time
+
+	// Links to images of the photo.
+	Images PhotoImages
+
+	// If available, information regarding the user, beer, brewery, and
+	// venue for the checkin this photo was attached to.  If a venue was
+	// not added to the checkin, Venue will be nil.
+	User    *User
+	Beer    *Beer
+	Brewery *Brewery
+	Venue   *Venue
+}
+
+// PhotoImages contains links to images of a Photo.  Included are links
+// to a small, medium, large, and original size image for a given Photo.
+type PhotoImages struct {
+	SmallImage    url.URL
+	MediumImage   url.URL
+	LargeImage    url.URL
+	OriginalImage url.URL
+}
+
+// rawPhoto is the raw JSON representation of an Untappd photo.  Its data is
+// unmarshaled from JSON and then exported to a Photo struct.
+type rawPhoto struct {
+	ID        int            `json:"photo_id"`
+	CheckinID int            `json:"checkin_id"`
+	Created   responseTime   `json:"created_at"`
+	Images    rawPhotoImages `json:"photo"`
+	User      *rawUser       `json:"user"`
+	Beer      *rawBeer       `json:"beer"`
+	Brewery   *rawBrewery    `json:"brewery"`
+	Venue     responseVenue  `json:"venue"`
+}
+
+// export creates an exported Photo from a rawPhoto struct, allowing for more
+// useful structures to be created for client consumption.
+func (r *rawPhoto) export() *Photo {
+	p := &Photo{
+		ID:        r.ID,
+		CheckinID: r.CheckinID,
+		Created:   time.Time(r.Created),
+		Images:    r.Images.export(),
+	}
+
+	if r.User != nil {
+		p.User = r.User.export()
+	}
+	if r.Beer != nil {
+		p.Beer = r.Beer.export()
+	}
+	if r.Brewery != nil {
+		p.Brewery = r.Brewery.export()
+	}
+
+	// If no venue was set in the response JSON, venue will be nil
+	if r.Venue.ID != 0 && r.Venue.Name != "" {
+		rv := rawVenue(r.Venue)
+		p.Venue = rv.export()
+	}
+
+	return p
+}
+
+// rawPhotoImages is the raw JSON representation of Untappd photo images.  Its
+// data is unmarshaled from JSON and then exported to a PhotoImages struct.
+type rawPhotoImages struct {
+	SmallImage    responseURL `json:"photo_img_sm"`
+	MediumImage   responseURL `json:"photo_img_md"`
+	LargeImage    responseURL `json:"photo_img_lg"`
+	OriginalImage responseURL `json:"photo_img_og"`
+}
+
+// export creates an exported PhotoImages from a rawPhotoImages struct, allowing
+// for more useful structures to be created for client consumption.
+func (r *rawPhotoImages) export() PhotoImages {
+	return PhotoImages{
+		SmallImage:    url.URL(r.SmallImage),
+		MediumImage:   url.URL(r.MediumImage),
+		LargeImage:    url.URL(r.LargeImage),
+		OriginalImage: url.URL(r.OriginalImage),
+	}
+}
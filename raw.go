@@ -0,0 +1,173 @@
+package untappd
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+)
+
+// rawMessageType is the reflect.Type of json.RawMessage, used to find the
+// fields which retain raw JSON in this package's raw types.
+var rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+
+// packagePath is the package path of this package, used to determine
+// which types may retain raw JSON.
+var packagePath = reflect.TypeOf(rawBeer{}).PkgPath()
+
+// captureRaw stores a copy of the original JSON for each of this package's
+// raw types in v, such as rawBeer, in its Raw field.  v must already have been
+// unmarshaled from b.  b is scanned only once, regardless of how deeply the
+// raw types are nested.
+//
+// field is the path to b within the response.  Values in b whose paths are
+// present in dropped were discarded before v was unmarshaled, and are skipped
+// so that the remaining array elements still line up with v.
+func captureRaw(b []byte, v interface{}, field string, dropped map[string]bool) error {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+
+	c := &rawCapturer{
+		d:       d,
+		b:       b,
+		dropped: dropped,
+	}
+
+	return c.value(reflect.ValueOf(v), field)
+}
+
+// A rawCapturer walks a JSON document alongside the Go value it was
+// unmarshaled into, to capture raw JSON.
+type rawCapturer struct {
+	d       *json.Decoder
+	b       []byte
+	dropped map[string]bool
+}
+
+// value consumes the next JSON value at field, descending into the
+// corresponding Go value v for objects and arrays.  If v is the zero Value,
+// the JSON value is consumed without capturing anything.
+func (c *rawCapturer) value(v reflect.Value, field string) error {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			v = reflect.Value{}
+			break
+		}
+
+		v = v.Elem()
+	}
+
+	tok, err := c.d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		return c.object(v, c.d.InputOffset()-1, field)
+	case json.Delim('['):
+		return c.array(v, field)
+	}
+
+	return nil
+}
+
+// object consumes the members of a JSON object which began at offset start,
+// capturing its raw JSON if v is one of this package's raw types.
+func (c *rawCapturer) object(v reflect.Value, start int64, field string) error {
+	isStruct := v.IsValid() && v.Kind() == reflect.Struct
+
+	for c.d.More() {
+		tok, err := c.d.Token()
+		if err != nil {
+			return err
+		}
+
+		k, _ := tok.(string)
+		kf := c.field(field, k, -1)
+
+		var fv reflect.Value
+		if isStruct && !c.dropped[kf] {
+			if f, ok := jsonField(v.Type(), k); ok {
+				fv = v.FieldByIndex(f.Index)
+			}
+		}
+
+		if err := c.value(fv, kf); err != nil {
+			return err
+		}
+	}
+
+	// Closing brace
+	if _, err := c.d.Token(); err != nil {
+		return err
+	}
+
+	if isStruct {
+		setRaw(v, c.b[start:c.d.InputOffset()])
+	}
+
+	return nil
+}
+
+// array consumes the elements of a JSON array, descending into the
+// corresponding elements of v, if v is a slice or array.  Discarded elements
+// have no corresponding element in v.
+func (c *rawCapturer) array(v reflect.Value, field string) error {
+	isSlice := v.IsValid() && (v.Kind() == reflect.Slice || v.Kind() == reflect.Array)
+
+	for i, j := 0, 0; c.d.More(); i++ {
+		ef := c.field(field, "", i)
+
+		var ev reflect.Value
+		if isSlice && !c.dropped[ef] {
+			if j < v.Len() {
+				ev = v.Index(j)
+			}
+			j++
+		}
+
+		if err := c.value(ev, ef); err != nil {
+			return err
+		}
+	}
+
+	// Closing bracket
+	_, err := c.d.Token()
+	return err
+}
+
+// field returns the path to object key k, or to array index i if i is not
+// negative, within parent.  Paths are only needed to look up discarded values,
+// so none are built if no values were discarded.
+func (c *rawCapturer) field(parent string, k string, i int) string {
+	if len(c.dropped) == 0 {
+		return ""
+	}
+	if i >= 0 {
+		return indexField(parent, i)
+	}
+
+	return joinField(parent, k)
+}
+
+// setRaw stores a copy of data in the Raw field of struct v, if v is one of
+// this package's raw types.  Exported types are left untouched, so that raw
+// JSON is never set on values passed to Client.Do by callers.
+func setRaw(v reflect.Value, data []byte) {
+	t := v.Type()
+	if t.PkgPath() != packagePath {
+		return
+	}
+
+	f, ok := t.FieldByName("Raw")
+	if !ok || f.Type != rawMessageType || f.Tag.Get("json") != "-" {
+		return
+	}
+
+	fv := v.FieldByIndex(f.Index)
+	if !fv.CanSet() {
+		return
+	}
+
+	fv.Set(reflect.ValueOf(json.RawMessage(append([]byte(nil), data...))))
+}
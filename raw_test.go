@@ -0,0 +1,214 @@
+package untappd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// TestClientRawJSONOK verifies that the original JSON of a Beer and its nested
+// types is retained when Client.RawJSON is enabled.
+func TestClientRawJSONOK(t *testing.T) {
+	c, done := beerInfoTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"response":{"beer":{"bid":1,"beer_foo":"bar","brewery":{"brewery_id":2,"brewery_foo":"baz"}}}}`))
+	})
+	defer done()
+
+	c.RawJSON = true
+
+	b, _, err := c.Beer.Info(1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var beer struct {
+		Foo string `json:"beer_foo"`
+	}
+	if err := json.Unmarshal(b.Raw, &beer); err != nil {
+		t.Fatal(err)
+	}
+	if f := beer.Foo; f != "bar" {
+		t.Fatalf("unexpected beer_foo: %q != %q", f, "bar")
+	}
+
+	var brewery struct {
+		Foo string `json:"brewery_foo"`
+	}
+	if err := json.Unmarshal(b.Brewery.Raw, &brewery); err != nil {
+		t.Fatal(err)
+	}
+	if f := brewery.Foo; f != "baz" {
+		t.Fatalf("unexpected brewery_foo: %q != %q", f, "baz")
+	}
+}
+
+// TestClientRawJSONNormalized verifies that raw JSON is captured from the
+// original response, rather than from normalized values, when a response
+// contains values with unexpected JSON types.
+func TestClientRawJSONNormalized(t *testing.T) {
+	c, done := beerInfoTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"response":{"beer":{"bid":"5","beer_abv":"n/a","similar":{"count":2,"items":["malformed",{"beer":{"bid":"7"}}]}}}}`))
+	})
+	defer done()
+
+	c.RawJSON = true
+
+	b, _, err := c.Beer.Info(1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if id := b.ID; id != 5 {
+		t.Fatalf("unexpected ID: %d != %d", id, 5)
+	}
+
+	want := `{"bid":"5","beer_abv":"n/a","similar":{"count":2,"items":["malformed",{"beer":{"bid":"7"}}]}}`
+	if s := string(b.Raw); s != want {
+		t.Fatalf("unexpected raw JSON:\n- want: %s\n-  got: %s", want, s)
+	}
+
+	// The malformed similar beer is discarded, so the remaining beer must
+	// still receive its own raw JSON
+	if l := len(b.Similar); l != 1 {
+		t.Fatalf("unexpected number of similar beers: %d != %d", l, 1)
+	}
+	if s := string(b.Similar[0].Raw); s != `{"bid":"7"}` {
+		t.Fatalf("unexpected similar beer raw JSON: %s", s)
+	}
+}
+
+// TestClientRawJSONDisabled verifies that no raw JSON is retained by default.
+func TestClientRawJSONDisabled(t *testing.T) {
+	c, done := beerInfoTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		w.Write(blackNoteBeerJSON)
+	})
+	defer done()
+
+	b, _, err := c.Beer.Info(1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if b.Raw != nil {
+		t.Fatalf("unexpected Raw: %s", string(b.Raw))
+	}
+	if b.Brewery.Raw != nil {
+		t.Fatalf("unexpected Brewery.Raw: %s", string(b.Brewery.Raw))
+	}
+	if b.Media[0].User.Raw != nil {
+		t.Fatalf("unexpected Media[0].User.Raw: %s", string(b.Media[0].User.Raw))
+	}
+	if b.Similar[0].Raw != nil {
+		t.Fatalf("unexpected Similar[0].Raw: %s", string(b.Similar[0].Raw))
+	}
+}
+
+// Test_captureRaw verifies that captureRaw stores a copy of the raw JSON for
+// nested raw types, and leaves exported types untouched.
+func Test_captureRaw(t *testing.T) {
+	b := []byte(`{"checkin":{"checkin_id":1,"beer":{"bid":2},"venue":[],"user":{"user_name":"foo"}},"beer":{"bid":3}}`)
+
+	var v struct {
+		Checkin rawCheckin `json:"checkin"`
+		Beer    Beer       `json:"beer"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		t.Fatal(err)
+	}
+	if err := captureRaw(b, &v, "", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"checkin": `{"checkin_id":1,"beer":{"bid":2},"venue":[],"user":{"user_name":"foo"}}`,
+		"beer":    `{"bid":2}`,
+		"user":    `{"user_name":"foo"}`,
+		"venue":   "",
+	}
+	got := map[string]string{
+		"checkin": string(v.Checkin.Raw),
+		"beer":    string(v.Checkin.Beer.Raw),
+		"user":    string(v.Checkin.User.Raw),
+		"venue":   string(v.Checkin.Venue.Raw),
+	}
+	for k := range want {
+		if want[k] != got[k] {
+			t.Fatalf("unexpected %s raw JSON:\n- want: %s\n-  got: %s", k, want[k], got[k])
+		}
+	}
+
+	if v.Beer.Raw != nil {
+		t.Fatalf("unexpected raw JSON for exported Beer: %s", string(v.Beer.Raw))
+	}
+
+	// Raw JSON must not share memory with the input
+	for i := range b {
+		b[i] = ' '
+	}
+	if s := string(v.Checkin.Beer.Raw); s != want["beer"] {
+		t.Fatalf("raw JSON modified with input: %q", s)
+	}
+}
+
+// TestClientDoGETOK verifies that Client.Do sends parameters in the query
+// string for GET requests, and unmarshals the result JSON.
+func TestClientDoGETOK(t *testing.T) {
+	c, done := testClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		path := "/v4/foo/bar/"
+		if p := r.URL.Path; p != path {
+			t.Fatalf("unexpected URL path: %q != %q", p, path)
+		}
+
+		assertParameters(t, r, url.Values{
+			"baz": []string{"qux"},
+		})
+
+		w.Write([]byte(`{"response":{"foo":"bar"}}`))
+	})
+	defer done()
+
+	var v struct {
+		Response json.RawMessage `json:"response"`
+	}
+
+	if _, err := c.Do("GET", "/foo/bar", url.Values{
+		"baz": []string{"qux"},
+	}, &v); err != nil {
+		t.Fatal(err)
+	}
+
+	// Raw JSON requested by the caller must never be discarded
+	want := `{"foo":"bar"}`
+	if got := string(v.Response); got != want {
+		t.Fatalf("unexpected response: %q != %q", got, want)
+	}
+}
+
+// TestClientDoPOSTOK verifies that Client.Do sends parameters in the request
+// body for POST requests.
+func TestClientDoPOSTOK(t *testing.T) {
+	c, done := testClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		if m := r.Method; m != "POST" {
+			t.Fatalf("unexpected HTTP method: %q != %q", m, "POST")
+		}
+
+		assertBodyParameters(t, r, url.Values{
+			"baz": []string{"qux"},
+		})
+		if q := r.URL.Query().Get("baz"); q != "" {
+			t.Fatalf("unexpected query parameter baz: %q", q)
+		}
+		if !strings.HasPrefix(r.URL.Path, "/v4/foo/") {
+			t.Fatalf("unexpected URL path: %q", r.URL.Path)
+		}
+	})
+	defer done()
+
+	if _, err := c.Do("POST", "foo", url.Values{
+		"baz": []string{"qux"},
+	}, nil); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -11,8 +11,8 @@ import (
 )
 
 var (
-	// errInvalidBool is returned when the Untappd API returns a
-	// non 0 or 1 integer for a boolean value.
+	// errInvalidBool is returned when the Untappd API returns an
+	// unrecognized value for a boolean value.
 	errInvalidBool = errors.New("invalid boolean value")
 
 	// errInvalidTimeUnit is returned when the Untappd API returns an
@@ -63,6 +63,13 @@ func (r *responseTime) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
+	// The API occasionally returns an empty string instead of a timestamp,
+	// which is treated as the zero time
+	if v == "" {
+		*r = responseTime{}
+		return nil
+	}
+
 	// Parse a Go time.Time from string
 	t, err := time.Parse(time.RFC1123Z, v)
 	if err != nil {
@@ -99,16 +106,36 @@ type responseBool bool
 
 // UnmarshalJSON implements json.Unmarshaler.
 func (r *responseBool) UnmarshalJSON(data []byte) error {
-	var v int
+	var v interface{}
 	if err := json.Unmarshal(data, &v); err != nil {
 		return err
 	}
 
-	switch v {
-	case 0:
+	// Booleans are typically returned as integer 0 or 1, but the API also
+	// returns true or false, and strings containing any of these values
+	switch v := v.(type) {
+	case nil:
 		*r = false
-	case 1:
-		*r = true
+	case bool:
+		*r = responseBool(v)
+	case float64:
+		switch v {
+		case 0:
+			*r = false
+		case 1:
+			*r = true
+		default:
+			return errInvalidBool
+		}
+	case string:
+		switch v {
+		case "0", "false":
+			*r = false
+		case "1", "true":
+			*r = true
+		default:
+			return errInvalidBool
+		}
 	default:
 		return errInvalidBool
 	}
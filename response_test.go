@@ -125,6 +125,11 @@ func Test_responseTimeUnmarshalJSON(t *testing.T) {
 			body:        []byte(`"` + time.RFC1123Z + `"`),
 			result:      time.Date(2006, time.January, 2, 15, 4, 5, 0, mst),
 		},
+		{
+			description: "empty string",
+			body:        []byte(`""`),
+			result:      time.Time{},
+		},
 		{
 			description: "bad time",
 			body:        []byte(`"01-01-2001"`),
@@ -271,11 +276,41 @@ func Test_responseBoolUnmarshalJSON(t *testing.T) {
 			body:        []byte(`1`),
 			result:      true,
 		},
+		{
+			description: "false",
+			body:        []byte(`false`),
+			result:      false,
+		},
+		{
+			description: "true",
+			body:        []byte(`true`),
+			result:      true,
+		},
+		{
+			description: "\"0\" (false)",
+			body:        []byte(`"0"`),
+			result:      false,
+		},
+		{
+			description: "\"true\" (true)",
+			body:        []byte(`"true"`),
+			result:      true,
+		},
+		{
+			description: "null (false)",
+			body:        []byte(`null`),
+			result:      false,
+		},
 		{
 			description: "2 (invalid)",
 			body:        []byte(`2`),
 			err:         errInvalidBool,
 		},
+		{
+			description: "\"yes\" (invalid)",
+			body:        []byte(`"yes"`),
+			err:         errInvalidBool,
+		},
 		{
 			description: "bad JSON",
 			body:        []byte(`}`),
@@ -417,6 +452,9 @@ func Test_responseVenueUnmarshalJSON(t *testing.T) {
 			t.Fatalf("unexpected error for test %q: %v != %v", tt.description, err, tt.err)
 		}
 
+		// Raw JSON is retained by rawVenue, but not under test here
+		r.Raw = nil
+
 		if !reflect.DeepEqual(*r, responseVenue(tt.result)) {
 			t.Fatalf("unexpected responseVenue for test %q: %v != %v", tt.description, r, tt.result)
 		}
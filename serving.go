@@ -0,0 +1,35 @@
+package untappd
+
+// ServingStyle is a style in which a beer was served, as reported by the
+// Untappd APIv4 for a checkin.  A set of ServingStyle constants
+// are provided for ease of use.
+type ServingStyle string
+
+// Constants that define various styles in which a beer may be served.
+const (
+	// ServingDraft indicates a beer was served on draft.
+	ServingDraft ServingStyle = "draft"
+
+	// ServingBottle indicates a beer was served from a bottle.
+	ServingBottle ServingStyle = "bottle"
+
+	// ServingCan indicates a beer was served from a can.
+	ServingCan ServingStyle = "can"
+
+	// ServingCask indicates a beer was served from a cask.
+	ServingCask ServingStyle = "cask"
+
+	// ServingTaster indicates a beer was served as a taster.
+	ServingTaster ServingStyle = "taster"
+)
+
+// ServingStyles returns a slice of all available ServingStyle constants.
+func ServingStyles() []ServingStyle {
+	return []ServingStyle{
+		ServingDraft,
+		ServingBottle,
+		ServingCan,
+		ServingCask,
+		ServingTaster,
+	}
+}
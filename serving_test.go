@@ -0,0 +1,28 @@
+package untappd
+
+import "testing"
+
+// TestServingStyles verifies that every ServingStyle type is present in the
+// output of ServingStyles.
+func TestServingStyles(t *testing.T) {
+	for _, s := range []ServingStyle{
+		ServingDraft,
+		ServingBottle,
+		ServingCan,
+		ServingCask,
+		ServingTaster,
+	} {
+		var found bool
+		for _, ss := range ServingStyles() {
+			if s == ss {
+				found = true
+				break
+			}
+		}
+		if found {
+			continue
+		}
+
+		t.Fatalf("unknown ServingStyle type: %q", s)
+	}
+}
@@ -30,10 +30,15 @@ type rawToast struct {
 // export creates an exported Toast from a rawToast struct, allowing for more
 // useful structures to be created for client consumption.
 func (r *rawToast) export() *Toast {
-	return &Toast{
+	t := &Toast{
 		ID:      r.ID,
 		UserID:  r.UserID,
 		Created: time.Time(r.Created),
-		User:    r.User.export(),
 	}
+
+	if r.User != nil {
+		t.User = r.User.export()
+	}
+
+	return t
 }
@@ -0,0 +1,182 @@
+package untappd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"iter"
+	"net/http"
+	"strings"
+)
+
+// A Tracer creates spans which trace the service methods called on a Client.  Tracer
+// and Span mirror a subset of the OpenTelemetry tracing API, so that they
+// may be implemented using OpenTelemetry without this package depending
+// upon it.
+type Tracer interface {
+	// Start creates a Span named name, as a child of any span in ctx, and
+	// returns a context containing the new Span.
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// A Span traces a single call to a service method of a Client, such as
+// BeerService.Info, including decoding its result.  Batch methods and
+// iterators, such as BeerService.InfoMany, are traced by a Span which is the
+// parent of the Span for each request they make.
+type Span interface {
+	// SetAttributes sets attributes describing the call.
+	SetAttributes(attrs ...Attribute)
+
+	// RecordError records an error which occurred during the call.
+	RecordError(err error)
+
+	// End completes the Span.
+	End()
+}
+
+// An Attribute is a key/value pair which describes a call traced by a Span.
+// Value is always a string or an int.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Attribute keys set on each Span.  AttributeErrorType is the Type of an
+// Error returned by the API, or "decode" if the response, or any item in
+// a list response, could not be decoded.
+const (
+	AttributeEndpoint   = "untappd.endpoint"
+	AttributeEntityID   = "untappd.entity_id"
+	AttributeStatusCode = "http.response.status_code"
+	AttributeErrorType  = "untappd.error_type"
+)
+
+// errorTypeDecode is the value of AttributeErrorType for a response which
+// could not be decoded.
+const errorTypeDecode = "decode"
+
+// spanKey is the context key for the Span started by a service method, so
+// that requests made by the method can describe themselves in its Span.
+type spanKey struct{}
+
+// startSpan starts a span named name, such as "untappd.Beer.Info", if the
+// Client has a Tracer, and returns a context containing it.
+func (c *Client) startSpan(ctx context.Context, name string) (context.Context, Span) {
+	if c.Tracer == nil {
+		return ctx, nil
+	}
+
+	ctx, span := c.Tracer.Start(ctx, name)
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+// traceRequest sets attributes describing a request to endpoint on the
+// span started by the service method making the request, if any.
+func (c *Client) traceRequest(ctx context.Context, endpoint string) {
+	span, ok := ctx.Value(spanKey{}).(Span)
+	if c.Tracer == nil || !ok {
+		return
+	}
+
+	attrs := []Attribute{{Key: AttributeEndpoint, Value: endpoint}}
+	if parts := strings.SplitN(endpoint, "/", 3); len(parts) == 3 && parts[2] != "" {
+		attrs = append(attrs, Attribute{Key: AttributeEntityID, Value: parts[2]})
+	}
+	span.SetAttributes(attrs...)
+}
+
+// endSpan records the outcome of a service method in span, if it is not nil,
+// and ends it.
+func endSpan(span Span, res *http.Response, err error) {
+	if span == nil {
+		return
+	}
+
+	if res != nil {
+		span.SetAttributes(Attribute{Key: AttributeStatusCode, Value: res.StatusCode})
+	}
+
+	if err != nil {
+		if typ := errorType(err); typ != "" {
+			span.SetAttributes(Attribute{Key: AttributeErrorType, Value: typ})
+		}
+
+		span.RecordError(err)
+	}
+
+	span.End()
+}
+
+// errorType returns the value of AttributeErrorType for err, or empty string
+// if err is neither an Error nor a decoding error.
+func errorType(err error) string {
+	switch err := err.(type) {
+	case *Error:
+		return err.Type
+	case ItemErrors:
+		for _, e := range err {
+			if isDecodeError(e.Err) {
+				return errorTypeDecode
+			}
+		}
+
+		return ""
+	}
+
+	if isDecodeError(err) {
+		return errorTypeDecode
+	}
+
+	return ""
+}
+
+// isDecodeError reports whether err occurred while decoding a response.
+func isDecodeError(err error) bool {
+	var serr *json.SyntaxError
+	var terr *json.UnmarshalTypeError
+
+	return errors.Is(err, ErrNullItem) || errors.As(err, &serr) || errors.As(err, &terr)
+}
+
+// traceCall invokes fn, a service method, within a span named name.  fn is
+// passed a context containing the span, and the span ends once fn has
+// returned, so that any errors decoding its result are recorded.
+func traceCall[T any](ctx context.Context, c *Client, name string, fn func(ctx context.Context) (T, *http.Response, error)) (T, *http.Response, error) {
+	ctx, span := c.startSpan(ctx, name)
+	v, res, err := fn(ctx)
+	endSpan(span, res, err)
+
+	return v, res, err
+}
+
+// traceSeq returns an iterator which invokes seq within a span named name,
+// which lasts for the entire iteration.  seq is passed a context containing
+// the span, so that each request it makes is traced as a child of the span.
+// The last request error yielded by seq, or else any ItemErrors, are recorded
+// in the span.
+func traceSeq[T any](ctx context.Context, c *Client, name string, seq func(ctx context.Context, yield func(T, error) bool)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		ctx, span := c.startSpan(ctx, name)
+		if span == nil {
+			seq(ctx, yield)
+			return
+		}
+
+		var err error
+		var errs ItemErrors
+		seq(ctx, func(v T, verr error) bool {
+			if ierr, ok := verr.(*ItemError); ok {
+				errs = append(errs, ierr)
+			} else if verr != nil {
+				err = verr
+			}
+
+			return yield(v, verr)
+		})
+
+		if err == nil && len(errs) > 0 {
+			err = errs
+		}
+		endSpan(span, nil, err)
+	}
+}
@@ -0,0 +1,288 @@
+package untappd
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// TestClientTracerOK verifies that a Client creates a span for each request,
+// named after the service method, with attributes describing the request.
+func TestClientTracerOK(t *testing.T) {
+	c, done := beerInfoTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		if path.Base(path.Clean(r.URL.Path)) == "-1" {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write(invalidBeerErrJSON)
+			return
+		}
+
+		w.Write([]byte(`{"response":{"beer":{"bid":1}}}`))
+	})
+	defer done()
+
+	tr := &testTracer{}
+	c.Tracer = tr
+
+	if _, _, err := c.Beer.Info(1, false); err != nil {
+		t.Fatal(err)
+	}
+	_, _, err := c.Beer.Info(-1, false)
+	assertInvalidBeerErr(t, err)
+
+	if l := len(tr.spans); l != 2 {
+		t.Fatalf("unexpected number of spans: %d != %d", l, 2)
+	}
+
+	ok, bad := tr.spans[0], tr.spans[1]
+	for _, s := range tr.spans {
+		if s.name != "untappd.Beer.Info" {
+			t.Fatalf("unexpected span name: %q != %q", s.name, "untappd.Beer.Info")
+		}
+		if !s.ended {
+			t.Fatalf("span %q was not ended", s.name)
+		}
+	}
+
+	expected := map[string]interface{}{
+		AttributeEndpoint:   "beer/info/1",
+		AttributeEntityID:   "1",
+		AttributeStatusCode: http.StatusOK,
+	}
+	if !reflect.DeepEqual(ok.attrs, expected) {
+		t.Fatalf("unexpected attributes:\n- want: %v\n-  got: %v", expected, ok.attrs)
+	}
+	if ok.err != nil {
+		t.Fatalf("unexpected error: %v", ok.err)
+	}
+
+	expected = map[string]interface{}{
+		AttributeEndpoint:   "beer/info/-1",
+		AttributeEntityID:   "-1",
+		AttributeStatusCode: http.StatusInternalServerError,
+		AttributeErrorType:  "invalid_param",
+	}
+	if !reflect.DeepEqual(bad.attrs, expected) {
+		t.Fatalf("unexpected attributes:\n- want: %v\n-  got: %v", expected, bad.attrs)
+	}
+	if bad.err != err {
+		t.Fatalf("unexpected recorded error: %v != %v", bad.err, err)
+	}
+}
+
+// TestClientTracerPropagatesContext verifies that spans created by methods
+// which accept a context are children of the span in that context, and that
+// batch methods create a span which is the parent of each request's span.
+func TestClientTracerPropagatesContext(t *testing.T) {
+	c, done := beerInfoTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"response":{"beer":{"bid":` + path.Base(path.Clean(r.URL.Path)) + `}}}`))
+	})
+	defer done()
+
+	tr := &testTracer{}
+	c.Tracer = tr
+
+	ctx, span := tr.Start(context.Background(), "parent")
+	parent := span.(*testSpan)
+
+	if _, _, err := c.Beer.InfoContext(ctx, 1, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Beer.InfoMany(ctx, []int{1, 2}, false); err != nil {
+		t.Fatal(err)
+	}
+
+	if l := len(tr.spans); l != 5 {
+		t.Fatalf("unexpected number of spans: %d != %d", l, 5)
+	}
+
+	info, many := tr.spans[1], tr.spans[2]
+	assertSpan(t, info, "untappd.Beer.Info", parent)
+	assertSpan(t, many, "untappd.Beer.InfoMany", parent)
+
+	for _, s := range tr.spans[3:] {
+		assertSpan(t, s, "untappd.Beer.Info", many)
+	}
+}
+
+// TestClientTracerSpanNames verifies that spans are named after the service
+// method which was called, and that iterators create a span which is the
+// parent of each request's span.
+func TestClientTracerSpanNames(t *testing.T) {
+	c, done := testClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"response":{}}`))
+	})
+	defer done()
+
+	tr := &testTracer{}
+	c.Tracer = tr
+
+	calls := []func() error{
+		func() error {
+			_, _, err := c.Auth.Checkin(CheckinRequest{})
+			return err
+		},
+		func() error {
+			_, _, err := c.Brewery.Beers(1)
+			return err
+		},
+		func() error {
+			_, _, err := c.Beer.Search("foo")
+			return err
+		},
+		func() error {
+			_, _, err := c.User.WishList("mdlayher")
+			return err
+		},
+		func() error {
+			_, err := c.Do("GET", "beer/info/1", nil, nil)
+			return err
+		},
+		func() error {
+			for _, err := range c.User.AllBadges(context.Background(), "mdlayher") {
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	for _, fn := range calls {
+		if err := fn(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected := []string{
+		"untappd.Auth.Checkin",
+		"untappd.Brewery.Beers",
+		"untappd.Beer.Search",
+		"untappd.User.WishList",
+		"untappd.Client.Do",
+		"untappd.User.AllBadges",
+		"untappd.User.Badges",
+	}
+
+	var names []string
+	for _, s := range tr.spans {
+		names = append(names, s.name)
+	}
+	if !reflect.DeepEqual(names, expected) {
+		t.Fatalf("unexpected span names:\n- want: %v\n-  got: %v", expected, names)
+	}
+
+	all := tr.spans[5]
+	assertSpan(t, all, "untappd.User.AllBadges", nil)
+	assertSpan(t, tr.spans[6], "untappd.User.Badges", all)
+}
+
+// TestClientTracerDecodeErrors verifies that spans end after a response is
+// decoded, and record any errors which occurred while decoding it.
+func TestClientTracerDecodeErrors(t *testing.T) {
+	var tests = []struct {
+		desc string
+		body string
+	}{
+		{
+			desc: "invalid JSON",
+			body: `{"response":`,
+		},
+		{
+			desc: "null and missing items",
+			body: `{"response":{"checkins":{"count":2,"items":[null]}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			c, done := testClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(tt.body))
+			})
+			defer done()
+
+			tr := &testTracer{}
+			c.Tracer = tr
+
+			_, _, err := c.User.Checkins("mdlayher")
+			if err == nil {
+				t.Fatal("expected an error, but none occurred")
+			}
+
+			if l := len(tr.spans); l != 1 {
+				t.Fatalf("unexpected number of spans: %d != %d", l, 1)
+			}
+
+			s := tr.spans[0]
+			assertSpan(t, s, "untappd.User.Checkins", nil)
+
+			if typ := s.attrs[AttributeErrorType]; typ != "decode" {
+				t.Fatalf("unexpected error type: %v != %q", typ, "decode")
+			}
+			if !reflect.DeepEqual(s.err, err) {
+				t.Fatalf("unexpected recorded error: %v != %v", s.err, err)
+			}
+		})
+	}
+}
+
+// assertSpan verifies that s is named name, is a child of parent, and has
+// ended.
+func assertSpan(t *testing.T, s *testSpan, name string, parent *testSpan) {
+	t.Helper()
+
+	if s.name != name {
+		t.Fatalf("unexpected span name: %q != %q", s.name, name)
+	}
+	if s.parent != parent {
+		t.Fatalf("span %q has an unexpected parent", s.name)
+	}
+	if !s.ended {
+		t.Fatalf("span %q was not ended", s.name)
+	}
+}
+
+// testTracer is a Tracer which records each span it creates.
+type testTracer struct {
+	mu    sync.Mutex
+	spans []*testSpan
+}
+
+// testSpanKey is the context key for the current testSpan.
+type testSpanKey struct{}
+
+func (tr *testTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	parent, _ := ctx.Value(testSpanKey{}).(*testSpan)
+	s := &testSpan{
+		name:   name,
+		parent: parent,
+		attrs:  make(map[string]interface{}),
+	}
+
+	tr.mu.Lock()
+	tr.spans = append(tr.spans, s)
+	tr.mu.Unlock()
+
+	return context.WithValue(ctx, testSpanKey{}, s), s
+}
+
+// testSpan is a Span created by testTracer.
+type testSpan struct {
+	name   string
+	parent *testSpan
+	attrs  map[string]interface{}
+	err    error
+	ended  bool
+}
+
+func (s *testSpan) SetAttributes(attrs ...Attribute) {
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value
+	}
+}
+
+func (s *testSpan) RecordError(err error) { s.err = err }
+func (s *testSpan) End()                  { s.ended = true }
@@ -0,0 +1,273 @@
+package untappdtest
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/mdlayher/untappd"
+)
+
+// object is a JSON object in an Untappd APIv4 response.
+type object = map[string]interface{}
+
+// list builds a JSON object containing a count and a list of items, as used
+// for most lists in Untappd APIv4 responses.
+func list(items []interface{}) object {
+	if items == nil {
+		items = []interface{}{}
+	}
+
+	return object{
+		"count": len(items),
+		"items": items,
+	}
+}
+
+// totalList builds a list which also reports a total count of items, as
+// used for checkin toasts and comments.
+func totalList(items []interface{}) object {
+	o := list(items)
+	o["total_count"] = len(items)
+
+	return o
+}
+
+// formatTime formats t in the same way as the Untappd APIv4.  The zero time
+// is formatted as an empty string.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC1123Z)
+}
+
+// formatBool formats b as the integer 0 or 1, in the same way as the
+// Untappd APIv4.
+func formatBool(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+// formatURL formats u as a string.
+func formatURL(u url.URL) string {
+	return u.String()
+}
+
+// encodeBeer encodes the basic information about b, without any of its
+// related entities.
+func encodeBeer(b *untappd.Beer) object {
+	return object{
+		"bid":              b.ID,
+		"beer_name":        b.Name,
+		"beer_label":       formatURL(b.Label),
+		"beer_abv":         b.ABV,
+		"beer_ibu":         b.IBU,
+		"beer_slug":        b.Slug,
+		"beer_style":       b.Style,
+		"beer_style_id":    b.StyleID,
+		"beer_description": b.Description,
+		"is_in_production": formatBool(b.InProduction),
+		"is_homebrew":      formatBool(b.Homebrew),
+		"is_vintage":       formatBool(b.Vintage),
+		"is_variant":       formatBool(b.Variant),
+		"created_at":       formatTime(b.Created),
+		"wish_list":        b.WishList,
+		"rating_score":     b.OverallRating,
+		"rating_count":     b.OverallCount,
+		"auth_rating":      b.UserRating,
+		"stats":            b.Stats,
+	}
+}
+
+// encodeBrewery encodes the basic information about b, without any of its
+// related entities.
+func encodeBrewery(b *untappd.Brewery) object {
+	if b == nil {
+		b = &untappd.Brewery{}
+	}
+
+	return object{
+		"brewery_id":          b.ID,
+		"brewery_name":        b.Name,
+		"brewery_slug":        b.Slug,
+		"brewery_label":       formatURL(b.Logo),
+		"country_name":        b.Country,
+		"brewery_description": b.Description,
+		"brewery_active":      formatBool(b.Active),
+		"location":            b.Location,
+		"contact":             b.Contact,
+		"brewery_type":        b.Type,
+		"brewery_type_id":     b.TypeID,
+		"beer_count":          b.BeerCount,
+		"rating":              b.Rating,
+		"stats":               b.Stats,
+		"claimed_status": object{
+			"is_claimed": b.Claimed,
+		},
+	}
+}
+
+// encodeUser encodes the basic information about u, without any of its
+// related entities.
+func encodeUser(u *untappd.User) object {
+	if u == nil {
+		u = &untappd.User{}
+	}
+
+	return object{
+		"uid":              u.UID,
+		"id":               u.ID,
+		"user_name":        u.UserName,
+		"first_name":       u.FirstName,
+		"last_name":        u.LastName,
+		"user_avatar":      formatURL(u.Avatar),
+		"user_cover_photo": formatURL(u.CoverPhoto),
+		"location":         u.Location,
+		"url":              formatURL(u.URL),
+		"bio":              u.Bio,
+		"is_supporter":     formatBool(u.Supporter),
+		"is_private":       formatBool(u.Private),
+		"account_type":     u.AccountType,
+		"relationship":     u.Relationship,
+		"date_joined":      formatTime(u.DateJoined),
+		"untappd_url":      formatURL(u.UntappdURL),
+		"stats":            u.Stats,
+	}
+}
+
+// encodeVenue encodes the basic information about v, without any of its
+// related entities.  A nil venue is encoded as an empty array, in the same
+// way as the Untappd APIv4.
+func encodeVenue(v *untappd.Venue) interface{} {
+	if v == nil {
+		return []interface{}{}
+	}
+
+	categories := make([]interface{}, 0, len(v.Categories))
+	for _, c := range v.Categories {
+		categories = append(categories, c)
+	}
+
+	return object{
+		"venue_id":         v.ID,
+		"venue_name":       v.Name,
+		"last_updated":     formatTime(v.Updated),
+		"primary_category": v.Category,
+		"public_venue":     v.Public,
+		"is_verified":      v.Verified,
+		"location":         v.Location,
+		"contact":          v.Contact,
+		"stats":            v.Stats,
+		"foursquare":       v.Foursquare,
+		"categories":       list(categories),
+	}
+}
+
+// encodeBadge encodes b and any of its levels.
+func encodeBadge(b *untappd.Badge) object {
+	o := object{
+		"badge_id":            b.ID,
+		"checkin_id":          b.CheckinID,
+		"badge_name":          b.Name,
+		"badge_description":   b.Description,
+		"badge_hint":          b.Hint,
+		"badge_active_status": formatBool(b.Active),
+		"created_at":          formatTime(b.Earned),
+		"media": object{
+			"badge_image_sm": formatURL(b.Media.SmallImage),
+			"badge_image_md": formatURL(b.Media.MediumImage),
+			"badge_image_lg": formatURL(b.Media.LargeImage),
+		},
+	}
+
+	// Badges without levels report an empty array instead of an object
+	if len(b.Levels) == 0 {
+		o["levels"] = []interface{}{}
+		return o
+	}
+
+	levels := make([]interface{}, 0, len(b.Levels))
+	for _, l := range b.Levels {
+		levels = append(levels, encodeBadge(l))
+	}
+	o["levels"] = list(levels)
+
+	return o
+}
+
+// encodeCheckin encodes c, along with the basic information about its beer,
+// brewery, user, and venues.
+func encodeCheckin(c *untappd.Checkin) object {
+	beer := c.Beer
+	if beer == nil {
+		beer = &untappd.Beer{}
+	}
+
+	brewery := c.Brewery
+	if brewery == nil {
+		brewery = beer.Brewery
+	}
+
+	flavors := make([]string, 0, len(c.Flavors))
+	for _, f := range c.Flavors {
+		flavors = append(flavors, string(f))
+	}
+
+	badges := make([]interface{}, 0, len(c.Badges))
+	for _, b := range c.Badges {
+		badges = append(badges, encodeBadge(b))
+	}
+
+	toasts := make([]interface{}, 0, len(c.Toasts))
+	for _, t := range c.Toasts {
+		toasts = append(toasts, object{
+			"like_id":    t.ID,
+			"uid":        t.UserID,
+			"created_at": formatTime(t.Created),
+			"user":       encodeUser(t.User),
+		})
+	}
+
+	comments := make([]interface{}, 0, len(c.Comments))
+	for _, cc := range c.Comments {
+		comments = append(comments, object{
+			"comment_id": cc.ID,
+			"checkin_id": cc.CheckinID,
+			"comment":    cc.Comment,
+			"created_at": formatTime(cc.Created),
+			"user":       encodeUser(cc.User),
+		})
+	}
+
+	return object{
+		"checkin_id":      c.ID,
+		"beer":            encodeBeer(beer),
+		"brewery":         encodeBrewery(brewery),
+		"user":            encodeUser(c.User),
+		"venue":           encodeVenue(c.Venue),
+		"rating_score":    c.UserRating,
+		"checkin_comment": c.Comment,
+		"created_at":      formatTime(c.Created),
+		"serving_type":    string(c.ServingStyle),
+		"flavor_profile":  flavors,
+		"purchase_venue":  encodeVenue(c.PurchaseVenue),
+		"source":          c.Source,
+		"badges":          list(badges),
+		"toasts":          totalList(toasts),
+		"comments":        totalList(comments),
+	}
+}
+
+// encodeCheckins encodes each of checkins as a list.
+func encodeCheckins(checkins []*untappd.Checkin) object {
+	items := make([]interface{}, 0, len(checkins))
+	for _, c := range checkins {
+		items = append(items, encodeCheckin(c))
+	}
+
+	return list(items)
+}
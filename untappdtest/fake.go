@@ -0,0 +1,55 @@
+package untappdtest
+
+import "sync"
+
+//go:generate go run gen.go
+
+// A Call is a method call recorded by a fake service, such as FakeBeerAPI.
+type Call struct {
+	// The name of the method, such as "Info".
+	Method string
+
+	// The arguments passed to the method, in order.
+	Args []interface{}
+}
+
+// calls records the method calls made to a fake service.  It is embedded in
+// each fake service, and is safe for concurrent use.
+type calls struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// record records a call to method with args.
+func (c *calls) record(method string, args ...interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calls = append(c.calls, Call{
+		Method: method,
+		Args:   args,
+	})
+}
+
+// Calls returns each method call made to the fake service, in order.
+func (c *calls) Calls() []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]Call(nil), c.calls...)
+}
+
+// CallsTo returns each call made to the fake service's method, in order.
+func (c *calls) CallsTo(method string) []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var out []Call
+	for _, call := range c.calls {
+		if call.Method == method {
+			out = append(out, call)
+		}
+	}
+
+	return out
+}
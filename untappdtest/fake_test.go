@@ -0,0 +1,104 @@
+package untappdtest
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/mdlayher/untappd"
+)
+
+// Ensure each fake implements its service interface.
+var (
+	_ untappd.AuthAPI    = &FakeAuthAPI{}
+	_ untappd.BeerAPI    = &FakeBeerAPI{}
+	_ untappd.BreweryAPI = &FakeBreweryAPI{}
+	_ untappd.LocalAPI   = &FakeLocalAPI{}
+	_ untappd.UserAPI    = &FakeUserAPI{}
+	_ untappd.VenueAPI   = &FakeVenueAPI{}
+)
+
+// TestFakeBeerAPIScripted verifies that a fake service returns scripted
+// responses when used in place of a Client's service, and records each call.
+func TestFakeBeerAPIScripted(t *testing.T) {
+	errBad := errors.New("bad beer")
+
+	fake := &FakeBeerAPI{
+		InfoFunc: func(id int, compact bool) (*untappd.Beer, *http.Response, error) {
+			if id < 0 {
+				return nil, nil, errBad
+			}
+
+			return &untappd.Beer{ID: id, Name: "Two Hearted Ale"}, nil, nil
+		},
+	}
+
+	c, err := untappd.NewClient("foo", "bar", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Beer = fake
+
+	beer, _, err := c.Beer.Info(1, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if beer.Name != "Two Hearted Ale" {
+		t.Fatalf("unexpected beer Name: %q != %q", beer.Name, "Two Hearted Ale")
+	}
+
+	if _, _, err := c.Beer.Info(-1, false); err != errBad {
+		t.Fatalf("unexpected error: %v != %v", err, errBad)
+	}
+
+	// Unscripted methods return zero values
+	beers, _, err := c.Beer.Search("foo")
+	if beers != nil || err != nil {
+		t.Fatalf("unexpected unscripted results: %v, %v", beers, err)
+	}
+
+	expected := []Call{
+		{Method: "Info", Args: []interface{}{1, true}},
+		{Method: "Info", Args: []interface{}{-1, false}},
+		{Method: "Search", Args: []interface{}{"foo"}},
+	}
+	if calls := fake.Calls(); !reflect.DeepEqual(calls, expected) {
+		t.Fatalf("unexpected calls:\n- want: %v\n-  got: %v", expected, calls)
+	}
+
+	if l := len(fake.CallsTo("Info")); l != 2 {
+		t.Fatalf("unexpected number of Info calls: %d != %d", l, 2)
+	}
+}
+
+// TestFakeUserAPIIterator verifies that an unscripted iterator method returns
+// an empty iterator, and that a scripted one is used as-is.
+func TestFakeUserAPIIterator(t *testing.T) {
+	fake := &FakeUserAPI{}
+
+	for range fake.AllCheckins(context.Background(), "mdlayher") {
+		t.Fatal("unexpected item from unscripted iterator")
+	}
+
+	fake.AllFriendsFunc = func(ctx context.Context, username string) iter.Seq2[*untappd.User, error] {
+		return func(yield func(*untappd.User, error) bool) {
+			yield(&untappd.User{UserName: "untappd"}, nil)
+		}
+	}
+
+	var names []string
+	for u, err := range fake.AllFriends(context.Background(), "mdlayher") {
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		names = append(names, u.UserName)
+	}
+
+	if !reflect.DeepEqual(names, []string{"untappd"}) {
+		t.Fatalf("unexpected friends: %v", names)
+	}
+}
@@ -0,0 +1,1122 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package untappdtest
+
+import (
+	"context"
+	"iter"
+	"net/http"
+
+	"github.com/mdlayher/untappd"
+)
+
+// FakeAuthAPI is a fake implementation of untappd.AuthAPI, which records each
+// method call and returns scripted responses.  If a method's Func field is
+// not set, the method returns zero values, or an empty iterator.
+type FakeAuthAPI struct {
+	CheckinFunc                      func(r untappd.CheckinRequest) (*untappd.Checkin, *http.Response, error)
+	CheckinContextFunc               func(ctx context.Context, r untappd.CheckinRequest) (*untappd.Checkin, *http.Response, error)
+	AllCheckinsFunc                  func(ctx context.Context) iter.Seq2[*untappd.Checkin, error]
+	CheckinsFunc                     func() ([]*untappd.Checkin, *http.Response, error)
+	CheckinsContextFunc              func(ctx context.Context) ([]*untappd.Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimitFunc        func(minID int, maxID int, limit int) ([]*untappd.Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimitContextFunc func(ctx context.Context, minID int, maxID int, limit int) ([]*untappd.Checkin, *http.Response, error)
+
+	calls
+}
+
+// Checkin implements untappd.AuthAPI.
+func (f *FakeAuthAPI) Checkin(r untappd.CheckinRequest) (*untappd.Checkin, *http.Response, error) {
+	f.record("Checkin", r)
+	if f.CheckinFunc != nil {
+		return f.CheckinFunc(r)
+	}
+
+	var r0 *untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CheckinContext implements untappd.AuthAPI.
+func (f *FakeAuthAPI) CheckinContext(ctx context.Context, r untappd.CheckinRequest) (*untappd.Checkin, *http.Response, error) {
+	f.record("CheckinContext", ctx, r)
+	if f.CheckinContextFunc != nil {
+		return f.CheckinContextFunc(ctx, r)
+	}
+
+	var r0 *untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// AllCheckins implements untappd.AuthAPI.
+func (f *FakeAuthAPI) AllCheckins(ctx context.Context) iter.Seq2[*untappd.Checkin, error] {
+	f.record("AllCheckins", ctx)
+	if f.AllCheckinsFunc != nil {
+		return f.AllCheckinsFunc(ctx)
+	}
+
+	return func(yield func(*untappd.Checkin, error) bool) {}
+}
+
+// Checkins implements untappd.AuthAPI.
+func (f *FakeAuthAPI) Checkins() ([]*untappd.Checkin, *http.Response, error) {
+	f.record("Checkins")
+	if f.CheckinsFunc != nil {
+		return f.CheckinsFunc()
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CheckinsContext implements untappd.AuthAPI.
+func (f *FakeAuthAPI) CheckinsContext(ctx context.Context) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("CheckinsContext", ctx)
+	if f.CheckinsContextFunc != nil {
+		return f.CheckinsContextFunc(ctx)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CheckinsMinMaxIDLimit implements untappd.AuthAPI.
+func (f *FakeAuthAPI) CheckinsMinMaxIDLimit(minID int, maxID int, limit int) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("CheckinsMinMaxIDLimit", minID, maxID, limit)
+	if f.CheckinsMinMaxIDLimitFunc != nil {
+		return f.CheckinsMinMaxIDLimitFunc(minID, maxID, limit)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CheckinsMinMaxIDLimitContext implements untappd.AuthAPI.
+func (f *FakeAuthAPI) CheckinsMinMaxIDLimitContext(ctx context.Context, minID int, maxID int, limit int) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("CheckinsMinMaxIDLimitContext", ctx, minID, maxID, limit)
+	if f.CheckinsMinMaxIDLimitContextFunc != nil {
+		return f.CheckinsMinMaxIDLimitContextFunc(ctx, minID, maxID, limit)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeBeerAPI is a fake implementation of untappd.BeerAPI, which records each
+// method call and returns scripted responses.  If a method's Func field is
+// not set, the method returns zero values, or an empty iterator.
+type FakeBeerAPI struct {
+	AllCheckinsFunc                  func(ctx context.Context, id int) iter.Seq2[*untappd.Checkin, error]
+	CheckinsFunc                     func(id int) ([]*untappd.Checkin, *http.Response, error)
+	CheckinsContextFunc              func(ctx context.Context, id int) ([]*untappd.Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimitFunc        func(id int, minID int, maxID int, limit int) ([]*untappd.Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimitContextFunc func(ctx context.Context, id int, minID int, maxID int, limit int) ([]*untappd.Checkin, *http.Response, error)
+	InfoFunc                         func(id int, compact bool) (*untappd.Beer, *http.Response, error)
+	InfoContextFunc                  func(ctx context.Context, id int, compact bool) (*untappd.Beer, *http.Response, error)
+	InfoManyFunc                     func(ctx context.Context, ids []int, compact bool) ([]*untappd.Beer, error)
+	AllSearchFunc                    func(ctx context.Context, query string, sort untappd.Sort) iter.Seq2[*untappd.Beer, error]
+	SearchFunc                       func(query string) ([]*untappd.Beer, *http.Response, error)
+	SearchContextFunc                func(ctx context.Context, query string) ([]*untappd.Beer, *http.Response, error)
+	SearchOffsetLimitSortFunc        func(query string, offset int, limit int, sort untappd.Sort) ([]*untappd.Beer, *http.Response, error)
+	SearchOffsetLimitSortContextFunc func(ctx context.Context, query string, offset int, limit int, sort untappd.Sort) ([]*untappd.Beer, *http.Response, error)
+
+	calls
+}
+
+// AllCheckins implements untappd.BeerAPI.
+func (f *FakeBeerAPI) AllCheckins(ctx context.Context, id int) iter.Seq2[*untappd.Checkin, error] {
+	f.record("AllCheckins", ctx, id)
+	if f.AllCheckinsFunc != nil {
+		return f.AllCheckinsFunc(ctx, id)
+	}
+
+	return func(yield func(*untappd.Checkin, error) bool) {}
+}
+
+// Checkins implements untappd.BeerAPI.
+func (f *FakeBeerAPI) Checkins(id int) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("Checkins", id)
+	if f.CheckinsFunc != nil {
+		return f.CheckinsFunc(id)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CheckinsContext implements untappd.BeerAPI.
+func (f *FakeBeerAPI) CheckinsContext(ctx context.Context, id int) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("CheckinsContext", ctx, id)
+	if f.CheckinsContextFunc != nil {
+		return f.CheckinsContextFunc(ctx, id)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CheckinsMinMaxIDLimit implements untappd.BeerAPI.
+func (f *FakeBeerAPI) CheckinsMinMaxIDLimit(id int, minID int, maxID int, limit int) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("CheckinsMinMaxIDLimit", id, minID, maxID, limit)
+	if f.CheckinsMinMaxIDLimitFunc != nil {
+		return f.CheckinsMinMaxIDLimitFunc(id, minID, maxID, limit)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CheckinsMinMaxIDLimitContext implements untappd.BeerAPI.
+func (f *FakeBeerAPI) CheckinsMinMaxIDLimitContext(ctx context.Context, id int, minID int, maxID int, limit int) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("CheckinsMinMaxIDLimitContext", ctx, id, minID, maxID, limit)
+	if f.CheckinsMinMaxIDLimitContextFunc != nil {
+		return f.CheckinsMinMaxIDLimitContextFunc(ctx, id, minID, maxID, limit)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Info implements untappd.BeerAPI.
+func (f *FakeBeerAPI) Info(id int, compact bool) (*untappd.Beer, *http.Response, error) {
+	f.record("Info", id, compact)
+	if f.InfoFunc != nil {
+		return f.InfoFunc(id, compact)
+	}
+
+	var r0 *untappd.Beer
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// InfoContext implements untappd.BeerAPI.
+func (f *FakeBeerAPI) InfoContext(ctx context.Context, id int, compact bool) (*untappd.Beer, *http.Response, error) {
+	f.record("InfoContext", ctx, id, compact)
+	if f.InfoContextFunc != nil {
+		return f.InfoContextFunc(ctx, id, compact)
+	}
+
+	var r0 *untappd.Beer
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// InfoMany implements untappd.BeerAPI.
+func (f *FakeBeerAPI) InfoMany(ctx context.Context, ids []int, compact bool) ([]*untappd.Beer, error) {
+	f.record("InfoMany", ctx, ids, compact)
+	if f.InfoManyFunc != nil {
+		return f.InfoManyFunc(ctx, ids, compact)
+	}
+
+	var r0 []*untappd.Beer
+	var r1 error
+	return r0, r1
+}
+
+// AllSearch implements untappd.BeerAPI.
+func (f *FakeBeerAPI) AllSearch(ctx context.Context, query string, sort untappd.Sort) iter.Seq2[*untappd.Beer, error] {
+	f.record("AllSearch", ctx, query, sort)
+	if f.AllSearchFunc != nil {
+		return f.AllSearchFunc(ctx, query, sort)
+	}
+
+	return func(yield func(*untappd.Beer, error) bool) {}
+}
+
+// Search implements untappd.BeerAPI.
+func (f *FakeBeerAPI) Search(query string) ([]*untappd.Beer, *http.Response, error) {
+	f.record("Search", query)
+	if f.SearchFunc != nil {
+		return f.SearchFunc(query)
+	}
+
+	var r0 []*untappd.Beer
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// SearchContext implements untappd.BeerAPI.
+func (f *FakeBeerAPI) SearchContext(ctx context.Context, query string) ([]*untappd.Beer, *http.Response, error) {
+	f.record("SearchContext", ctx, query)
+	if f.SearchContextFunc != nil {
+		return f.SearchContextFunc(ctx, query)
+	}
+
+	var r0 []*untappd.Beer
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// SearchOffsetLimitSort implements untappd.BeerAPI.
+func (f *FakeBeerAPI) SearchOffsetLimitSort(query string, offset int, limit int, sort untappd.Sort) ([]*untappd.Beer, *http.Response, error) {
+	f.record("SearchOffsetLimitSort", query, offset, limit, sort)
+	if f.SearchOffsetLimitSortFunc != nil {
+		return f.SearchOffsetLimitSortFunc(query, offset, limit, sort)
+	}
+
+	var r0 []*untappd.Beer
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// SearchOffsetLimitSortContext implements untappd.BeerAPI.
+func (f *FakeBeerAPI) SearchOffsetLimitSortContext(ctx context.Context, query string, offset int, limit int, sort untappd.Sort) ([]*untappd.Beer, *http.Response, error) {
+	f.record("SearchOffsetLimitSortContext", ctx, query, offset, limit, sort)
+	if f.SearchOffsetLimitSortContextFunc != nil {
+		return f.SearchOffsetLimitSortContextFunc(ctx, query, offset, limit, sort)
+	}
+
+	var r0 []*untappd.Beer
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeBreweryAPI is a fake implementation of untappd.BreweryAPI, which records each
+// method call and returns scripted responses.  If a method's Func field is
+// not set, the method returns zero values, or an empty iterator.
+type FakeBreweryAPI struct {
+	AllBeersFunc                     func(ctx context.Context, id int, sort untappd.Sort) iter.Seq2[*untappd.Beer, error]
+	BeersFunc                        func(id int) ([]*untappd.Beer, *http.Response, error)
+	BeersContextFunc                 func(ctx context.Context, id int) ([]*untappd.Beer, *http.Response, error)
+	BeersOffsetLimitSortFunc         func(id int, offset int, limit int, sort untappd.Sort) ([]*untappd.Beer, *http.Response, error)
+	BeersOffsetLimitSortContextFunc  func(ctx context.Context, id int, offset int, limit int, sort untappd.Sort) ([]*untappd.Beer, *http.Response, error)
+	AllCheckinsFunc                  func(ctx context.Context, id int) iter.Seq2[*untappd.Checkin, error]
+	CheckinsFunc                     func(id int) ([]*untappd.Checkin, *http.Response, error)
+	CheckinsContextFunc              func(ctx context.Context, id int) ([]*untappd.Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimitFunc        func(id int, minID int, maxID int, limit int) ([]*untappd.Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimitContextFunc func(ctx context.Context, id int, minID int, maxID int, limit int) ([]*untappd.Checkin, *http.Response, error)
+	InfoFunc                         func(id int, compact bool) (*untappd.Brewery, *http.Response, error)
+	InfoContextFunc                  func(ctx context.Context, id int, compact bool) (*untappd.Brewery, *http.Response, error)
+	InfoManyFunc                     func(ctx context.Context, ids []int, compact bool) ([]*untappd.Brewery, error)
+	AllSearchFunc                    func(ctx context.Context, query string) iter.Seq2[*untappd.Brewery, error]
+	SearchFunc                       func(query string) ([]*untappd.Brewery, *http.Response, error)
+	SearchContextFunc                func(ctx context.Context, query string) ([]*untappd.Brewery, *http.Response, error)
+	SearchOffsetLimitFunc            func(query string, offset int, limit int) ([]*untappd.Brewery, *http.Response, error)
+	SearchOffsetLimitContextFunc     func(ctx context.Context, query string, offset int, limit int) ([]*untappd.Brewery, *http.Response, error)
+
+	calls
+}
+
+// AllBeers implements untappd.BreweryAPI.
+func (f *FakeBreweryAPI) AllBeers(ctx context.Context, id int, sort untappd.Sort) iter.Seq2[*untappd.Beer, error] {
+	f.record("AllBeers", ctx, id, sort)
+	if f.AllBeersFunc != nil {
+		return f.AllBeersFunc(ctx, id, sort)
+	}
+
+	return func(yield func(*untappd.Beer, error) bool) {}
+}
+
+// Beers implements untappd.BreweryAPI.
+func (f *FakeBreweryAPI) Beers(id int) ([]*untappd.Beer, *http.Response, error) {
+	f.record("Beers", id)
+	if f.BeersFunc != nil {
+		return f.BeersFunc(id)
+	}
+
+	var r0 []*untappd.Beer
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// BeersContext implements untappd.BreweryAPI.
+func (f *FakeBreweryAPI) BeersContext(ctx context.Context, id int) ([]*untappd.Beer, *http.Response, error) {
+	f.record("BeersContext", ctx, id)
+	if f.BeersContextFunc != nil {
+		return f.BeersContextFunc(ctx, id)
+	}
+
+	var r0 []*untappd.Beer
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// BeersOffsetLimitSort implements untappd.BreweryAPI.
+func (f *FakeBreweryAPI) BeersOffsetLimitSort(id int, offset int, limit int, sort untappd.Sort) ([]*untappd.Beer, *http.Response, error) {
+	f.record("BeersOffsetLimitSort", id, offset, limit, sort)
+	if f.BeersOffsetLimitSortFunc != nil {
+		return f.BeersOffsetLimitSortFunc(id, offset, limit, sort)
+	}
+
+	var r0 []*untappd.Beer
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// BeersOffsetLimitSortContext implements untappd.BreweryAPI.
+func (f *FakeBreweryAPI) BeersOffsetLimitSortContext(ctx context.Context, id int, offset int, limit int, sort untappd.Sort) ([]*untappd.Beer, *http.Response, error) {
+	f.record("BeersOffsetLimitSortContext", ctx, id, offset, limit, sort)
+	if f.BeersOffsetLimitSortContextFunc != nil {
+		return f.BeersOffsetLimitSortContextFunc(ctx, id, offset, limit, sort)
+	}
+
+	var r0 []*untappd.Beer
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// AllCheckins implements untappd.BreweryAPI.
+func (f *FakeBreweryAPI) AllCheckins(ctx context.Context, id int) iter.Seq2[*untappd.Checkin, error] {
+	f.record("AllCheckins", ctx, id)
+	if f.AllCheckinsFunc != nil {
+		return f.AllCheckinsFunc(ctx, id)
+	}
+
+	return func(yield func(*untappd.Checkin, error) bool) {}
+}
+
+// Checkins implements untappd.BreweryAPI.
+func (f *FakeBreweryAPI) Checkins(id int) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("Checkins", id)
+	if f.CheckinsFunc != nil {
+		return f.CheckinsFunc(id)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CheckinsContext implements untappd.BreweryAPI.
+func (f *FakeBreweryAPI) CheckinsContext(ctx context.Context, id int) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("CheckinsContext", ctx, id)
+	if f.CheckinsContextFunc != nil {
+		return f.CheckinsContextFunc(ctx, id)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CheckinsMinMaxIDLimit implements untappd.BreweryAPI.
+func (f *FakeBreweryAPI) CheckinsMinMaxIDLimit(id int, minID int, maxID int, limit int) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("CheckinsMinMaxIDLimit", id, minID, maxID, limit)
+	if f.CheckinsMinMaxIDLimitFunc != nil {
+		return f.CheckinsMinMaxIDLimitFunc(id, minID, maxID, limit)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CheckinsMinMaxIDLimitContext implements untappd.BreweryAPI.
+func (f *FakeBreweryAPI) CheckinsMinMaxIDLimitContext(ctx context.Context, id int, minID int, maxID int, limit int) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("CheckinsMinMaxIDLimitContext", ctx, id, minID, maxID, limit)
+	if f.CheckinsMinMaxIDLimitContextFunc != nil {
+		return f.CheckinsMinMaxIDLimitContextFunc(ctx, id, minID, maxID, limit)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Info implements untappd.BreweryAPI.
+func (f *FakeBreweryAPI) Info(id int, compact bool) (*untappd.Brewery, *http.Response, error) {
+	f.record("Info", id, compact)
+	if f.InfoFunc != nil {
+		return f.InfoFunc(id, compact)
+	}
+
+	var r0 *untappd.Brewery
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// InfoContext implements untappd.BreweryAPI.
+func (f *FakeBreweryAPI) InfoContext(ctx context.Context, id int, compact bool) (*untappd.Brewery, *http.Response, error) {
+	f.record("InfoContext", ctx, id, compact)
+	if f.InfoContextFunc != nil {
+		return f.InfoContextFunc(ctx, id, compact)
+	}
+
+	var r0 *untappd.Brewery
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// InfoMany implements untappd.BreweryAPI.
+func (f *FakeBreweryAPI) InfoMany(ctx context.Context, ids []int, compact bool) ([]*untappd.Brewery, error) {
+	f.record("InfoMany", ctx, ids, compact)
+	if f.InfoManyFunc != nil {
+		return f.InfoManyFunc(ctx, ids, compact)
+	}
+
+	var r0 []*untappd.Brewery
+	var r1 error
+	return r0, r1
+}
+
+// AllSearch implements untappd.BreweryAPI.
+func (f *FakeBreweryAPI) AllSearch(ctx context.Context, query string) iter.Seq2[*untappd.Brewery, error] {
+	f.record("AllSearch", ctx, query)
+	if f.AllSearchFunc != nil {
+		return f.AllSearchFunc(ctx, query)
+	}
+
+	return func(yield func(*untappd.Brewery, error) bool) {}
+}
+
+// Search implements untappd.BreweryAPI.
+func (f *FakeBreweryAPI) Search(query string) ([]*untappd.Brewery, *http.Response, error) {
+	f.record("Search", query)
+	if f.SearchFunc != nil {
+		return f.SearchFunc(query)
+	}
+
+	var r0 []*untappd.Brewery
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// SearchContext implements untappd.BreweryAPI.
+func (f *FakeBreweryAPI) SearchContext(ctx context.Context, query string) ([]*untappd.Brewery, *http.Response, error) {
+	f.record("SearchContext", ctx, query)
+	if f.SearchContextFunc != nil {
+		return f.SearchContextFunc(ctx, query)
+	}
+
+	var r0 []*untappd.Brewery
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// SearchOffsetLimit implements untappd.BreweryAPI.
+func (f *FakeBreweryAPI) SearchOffsetLimit(query string, offset int, limit int) ([]*untappd.Brewery, *http.Response, error) {
+	f.record("SearchOffsetLimit", query, offset, limit)
+	if f.SearchOffsetLimitFunc != nil {
+		return f.SearchOffsetLimitFunc(query, offset, limit)
+	}
+
+	var r0 []*untappd.Brewery
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// SearchOffsetLimitContext implements untappd.BreweryAPI.
+func (f *FakeBreweryAPI) SearchOffsetLimitContext(ctx context.Context, query string, offset int, limit int) ([]*untappd.Brewery, *http.Response, error) {
+	f.record("SearchOffsetLimitContext", ctx, query, offset, limit)
+	if f.SearchOffsetLimitContextFunc != nil {
+		return f.SearchOffsetLimitContextFunc(ctx, query, offset, limit)
+	}
+
+	var r0 []*untappd.Brewery
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeLocalAPI is a fake implementation of untappd.LocalAPI, which records each
+// method call and returns scripted responses.  If a method's Func field is
+// not set, the method returns zero values, or an empty iterator.
+type FakeLocalAPI struct {
+	AllCheckinsFunc                        func(ctx context.Context, r untappd.LocalCheckinsRequest) iter.Seq2[*untappd.Checkin, error]
+	CheckinsFunc                           func(latitude float64, longitude float64) ([]*untappd.Checkin, *http.Response, error)
+	CheckinsContextFunc                    func(ctx context.Context, latitude float64, longitude float64) ([]*untappd.Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimitRadiusFunc        func(r untappd.LocalCheckinsRequest) ([]*untappd.Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimitRadiusContextFunc func(ctx context.Context, r untappd.LocalCheckinsRequest) ([]*untappd.Checkin, *http.Response, error)
+
+	calls
+}
+
+// AllCheckins implements untappd.LocalAPI.
+func (f *FakeLocalAPI) AllCheckins(ctx context.Context, r untappd.LocalCheckinsRequest) iter.Seq2[*untappd.Checkin, error] {
+	f.record("AllCheckins", ctx, r)
+	if f.AllCheckinsFunc != nil {
+		return f.AllCheckinsFunc(ctx, r)
+	}
+
+	return func(yield func(*untappd.Checkin, error) bool) {}
+}
+
+// Checkins implements untappd.LocalAPI.
+func (f *FakeLocalAPI) Checkins(latitude float64, longitude float64) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("Checkins", latitude, longitude)
+	if f.CheckinsFunc != nil {
+		return f.CheckinsFunc(latitude, longitude)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CheckinsContext implements untappd.LocalAPI.
+func (f *FakeLocalAPI) CheckinsContext(ctx context.Context, latitude float64, longitude float64) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("CheckinsContext", ctx, latitude, longitude)
+	if f.CheckinsContextFunc != nil {
+		return f.CheckinsContextFunc(ctx, latitude, longitude)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CheckinsMinMaxIDLimitRadius implements untappd.LocalAPI.
+func (f *FakeLocalAPI) CheckinsMinMaxIDLimitRadius(r untappd.LocalCheckinsRequest) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("CheckinsMinMaxIDLimitRadius", r)
+	if f.CheckinsMinMaxIDLimitRadiusFunc != nil {
+		return f.CheckinsMinMaxIDLimitRadiusFunc(r)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CheckinsMinMaxIDLimitRadiusContext implements untappd.LocalAPI.
+func (f *FakeLocalAPI) CheckinsMinMaxIDLimitRadiusContext(ctx context.Context, r untappd.LocalCheckinsRequest) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("CheckinsMinMaxIDLimitRadiusContext", ctx, r)
+	if f.CheckinsMinMaxIDLimitRadiusContextFunc != nil {
+		return f.CheckinsMinMaxIDLimitRadiusContextFunc(ctx, r)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeUserAPI is a fake implementation of untappd.UserAPI, which records each
+// method call and returns scripted responses.  If a method's Func field is
+// not set, the method returns zero values, or an empty iterator.
+type FakeUserAPI struct {
+	AllBadgesFunc                      func(ctx context.Context, username string) iter.Seq2[*untappd.Badge, error]
+	BadgesFunc                         func(username string) ([]*untappd.Badge, *http.Response, error)
+	BadgesContextFunc                  func(ctx context.Context, username string) ([]*untappd.Badge, *http.Response, error)
+	BadgesOffsetLimitFunc              func(username string, offset int, limit int) ([]*untappd.Badge, *http.Response, error)
+	BadgesOffsetLimitContextFunc       func(ctx context.Context, username string, offset int, limit int) ([]*untappd.Badge, *http.Response, error)
+	AllBeersFunc                       func(ctx context.Context, username string, sort untappd.Sort) iter.Seq2[*untappd.Beer, error]
+	BeersFunc                          func(username string) ([]*untappd.Beer, *http.Response, error)
+	BeersContextFunc                   func(ctx context.Context, username string) ([]*untappd.Beer, *http.Response, error)
+	BeersOffsetLimitSortFunc           func(username string, offset int, limit int, sort untappd.Sort) ([]*untappd.Beer, *http.Response, error)
+	BeersOffsetLimitSortContextFunc    func(ctx context.Context, username string, offset int, limit int, sort untappd.Sort) ([]*untappd.Beer, *http.Response, error)
+	AllCheckinsFunc                    func(ctx context.Context, username string) iter.Seq2[*untappd.Checkin, error]
+	CheckinsFunc                       func(username string) ([]*untappd.Checkin, *http.Response, error)
+	CheckinsContextFunc                func(ctx context.Context, username string) ([]*untappd.Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimitFunc          func(username string, minID int, maxID int, limit int) ([]*untappd.Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimitContextFunc   func(ctx context.Context, username string, minID int, maxID int, limit int) ([]*untappd.Checkin, *http.Response, error)
+	AllFriendsFunc                     func(ctx context.Context, username string) iter.Seq2[*untappd.User, error]
+	FriendsFunc                        func(username string) ([]*untappd.User, *http.Response, error)
+	FriendsContextFunc                 func(ctx context.Context, username string) ([]*untappd.User, *http.Response, error)
+	FriendsOffsetLimitFunc             func(username string, offset int, limit int) ([]*untappd.User, *http.Response, error)
+	FriendsOffsetLimitContextFunc      func(ctx context.Context, username string, offset int, limit int) ([]*untappd.User, *http.Response, error)
+	InfoFunc                           func(username string, compact bool) (*untappd.User, *http.Response, error)
+	InfoContextFunc                    func(ctx context.Context, username string, compact bool) (*untappd.User, *http.Response, error)
+	InfoManyFunc                       func(ctx context.Context, usernames []string, compact bool) ([]*untappd.User, error)
+	AllWishListFunc                    func(ctx context.Context, username string, sort untappd.Sort) iter.Seq2[*untappd.Beer, error]
+	WishListFunc                       func(username string) ([]*untappd.Beer, *http.Response, error)
+	WishListContextFunc                func(ctx context.Context, username string) ([]*untappd.Beer, *http.Response, error)
+	WishListOffsetLimitSortFunc        func(username string, offset int, limit int, sort untappd.Sort) ([]*untappd.Beer, *http.Response, error)
+	WishListOffsetLimitSortContextFunc func(ctx context.Context, username string, offset int, limit int, sort untappd.Sort) ([]*untappd.Beer, *http.Response, error)
+
+	calls
+}
+
+// AllBadges implements untappd.UserAPI.
+func (f *FakeUserAPI) AllBadges(ctx context.Context, username string) iter.Seq2[*untappd.Badge, error] {
+	f.record("AllBadges", ctx, username)
+	if f.AllBadgesFunc != nil {
+		return f.AllBadgesFunc(ctx, username)
+	}
+
+	return func(yield func(*untappd.Badge, error) bool) {}
+}
+
+// Badges implements untappd.UserAPI.
+func (f *FakeUserAPI) Badges(username string) ([]*untappd.Badge, *http.Response, error) {
+	f.record("Badges", username)
+	if f.BadgesFunc != nil {
+		return f.BadgesFunc(username)
+	}
+
+	var r0 []*untappd.Badge
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// BadgesContext implements untappd.UserAPI.
+func (f *FakeUserAPI) BadgesContext(ctx context.Context, username string) ([]*untappd.Badge, *http.Response, error) {
+	f.record("BadgesContext", ctx, username)
+	if f.BadgesContextFunc != nil {
+		return f.BadgesContextFunc(ctx, username)
+	}
+
+	var r0 []*untappd.Badge
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// BadgesOffsetLimit implements untappd.UserAPI.
+func (f *FakeUserAPI) BadgesOffsetLimit(username string, offset int, limit int) ([]*untappd.Badge, *http.Response, error) {
+	f.record("BadgesOffsetLimit", username, offset, limit)
+	if f.BadgesOffsetLimitFunc != nil {
+		return f.BadgesOffsetLimitFunc(username, offset, limit)
+	}
+
+	var r0 []*untappd.Badge
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// BadgesOffsetLimitContext implements untappd.UserAPI.
+func (f *FakeUserAPI) BadgesOffsetLimitContext(ctx context.Context, username string, offset int, limit int) ([]*untappd.Badge, *http.Response, error) {
+	f.record("BadgesOffsetLimitContext", ctx, username, offset, limit)
+	if f.BadgesOffsetLimitContextFunc != nil {
+		return f.BadgesOffsetLimitContextFunc(ctx, username, offset, limit)
+	}
+
+	var r0 []*untappd.Badge
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// AllBeers implements untappd.UserAPI.
+func (f *FakeUserAPI) AllBeers(ctx context.Context, username string, sort untappd.Sort) iter.Seq2[*untappd.Beer, error] {
+	f.record("AllBeers", ctx, username, sort)
+	if f.AllBeersFunc != nil {
+		return f.AllBeersFunc(ctx, username, sort)
+	}
+
+	return func(yield func(*untappd.Beer, error) bool) {}
+}
+
+// Beers implements untappd.UserAPI.
+func (f *FakeUserAPI) Beers(username string) ([]*untappd.Beer, *http.Response, error) {
+	f.record("Beers", username)
+	if f.BeersFunc != nil {
+		return f.BeersFunc(username)
+	}
+
+	var r0 []*untappd.Beer
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// BeersContext implements untappd.UserAPI.
+func (f *FakeUserAPI) BeersContext(ctx context.Context, username string) ([]*untappd.Beer, *http.Response, error) {
+	f.record("BeersContext", ctx, username)
+	if f.BeersContextFunc != nil {
+		return f.BeersContextFunc(ctx, username)
+	}
+
+	var r0 []*untappd.Beer
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// BeersOffsetLimitSort implements untappd.UserAPI.
+func (f *FakeUserAPI) BeersOffsetLimitSort(username string, offset int, limit int, sort untappd.Sort) ([]*untappd.Beer, *http.Response, error) {
+	f.record("BeersOffsetLimitSort", username, offset, limit, sort)
+	if f.BeersOffsetLimitSortFunc != nil {
+		return f.BeersOffsetLimitSortFunc(username, offset, limit, sort)
+	}
+
+	var r0 []*untappd.Beer
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// BeersOffsetLimitSortContext implements untappd.UserAPI.
+func (f *FakeUserAPI) BeersOffsetLimitSortContext(ctx context.Context, username string, offset int, limit int, sort untappd.Sort) ([]*untappd.Beer, *http.Response, error) {
+	f.record("BeersOffsetLimitSortContext", ctx, username, offset, limit, sort)
+	if f.BeersOffsetLimitSortContextFunc != nil {
+		return f.BeersOffsetLimitSortContextFunc(ctx, username, offset, limit, sort)
+	}
+
+	var r0 []*untappd.Beer
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// AllCheckins implements untappd.UserAPI.
+func (f *FakeUserAPI) AllCheckins(ctx context.Context, username string) iter.Seq2[*untappd.Checkin, error] {
+	f.record("AllCheckins", ctx, username)
+	if f.AllCheckinsFunc != nil {
+		return f.AllCheckinsFunc(ctx, username)
+	}
+
+	return func(yield func(*untappd.Checkin, error) bool) {}
+}
+
+// Checkins implements untappd.UserAPI.
+func (f *FakeUserAPI) Checkins(username string) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("Checkins", username)
+	if f.CheckinsFunc != nil {
+		return f.CheckinsFunc(username)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CheckinsContext implements untappd.UserAPI.
+func (f *FakeUserAPI) CheckinsContext(ctx context.Context, username string) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("CheckinsContext", ctx, username)
+	if f.CheckinsContextFunc != nil {
+		return f.CheckinsContextFunc(ctx, username)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CheckinsMinMaxIDLimit implements untappd.UserAPI.
+func (f *FakeUserAPI) CheckinsMinMaxIDLimit(username string, minID int, maxID int, limit int) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("CheckinsMinMaxIDLimit", username, minID, maxID, limit)
+	if f.CheckinsMinMaxIDLimitFunc != nil {
+		return f.CheckinsMinMaxIDLimitFunc(username, minID, maxID, limit)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CheckinsMinMaxIDLimitContext implements untappd.UserAPI.
+func (f *FakeUserAPI) CheckinsMinMaxIDLimitContext(ctx context.Context, username string, minID int, maxID int, limit int) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("CheckinsMinMaxIDLimitContext", ctx, username, minID, maxID, limit)
+	if f.CheckinsMinMaxIDLimitContextFunc != nil {
+		return f.CheckinsMinMaxIDLimitContextFunc(ctx, username, minID, maxID, limit)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// AllFriends implements untappd.UserAPI.
+func (f *FakeUserAPI) AllFriends(ctx context.Context, username string) iter.Seq2[*untappd.User, error] {
+	f.record("AllFriends", ctx, username)
+	if f.AllFriendsFunc != nil {
+		return f.AllFriendsFunc(ctx, username)
+	}
+
+	return func(yield func(*untappd.User, error) bool) {}
+}
+
+// Friends implements untappd.UserAPI.
+func (f *FakeUserAPI) Friends(username string) ([]*untappd.User, *http.Response, error) {
+	f.record("Friends", username)
+	if f.FriendsFunc != nil {
+		return f.FriendsFunc(username)
+	}
+
+	var r0 []*untappd.User
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// FriendsContext implements untappd.UserAPI.
+func (f *FakeUserAPI) FriendsContext(ctx context.Context, username string) ([]*untappd.User, *http.Response, error) {
+	f.record("FriendsContext", ctx, username)
+	if f.FriendsContextFunc != nil {
+		return f.FriendsContextFunc(ctx, username)
+	}
+
+	var r0 []*untappd.User
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// FriendsOffsetLimit implements untappd.UserAPI.
+func (f *FakeUserAPI) FriendsOffsetLimit(username string, offset int, limit int) ([]*untappd.User, *http.Response, error) {
+	f.record("FriendsOffsetLimit", username, offset, limit)
+	if f.FriendsOffsetLimitFunc != nil {
+		return f.FriendsOffsetLimitFunc(username, offset, limit)
+	}
+
+	var r0 []*untappd.User
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// FriendsOffsetLimitContext implements untappd.UserAPI.
+func (f *FakeUserAPI) FriendsOffsetLimitContext(ctx context.Context, username string, offset int, limit int) ([]*untappd.User, *http.Response, error) {
+	f.record("FriendsOffsetLimitContext", ctx, username, offset, limit)
+	if f.FriendsOffsetLimitContextFunc != nil {
+		return f.FriendsOffsetLimitContextFunc(ctx, username, offset, limit)
+	}
+
+	var r0 []*untappd.User
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Info implements untappd.UserAPI.
+func (f *FakeUserAPI) Info(username string, compact bool) (*untappd.User, *http.Response, error) {
+	f.record("Info", username, compact)
+	if f.InfoFunc != nil {
+		return f.InfoFunc(username, compact)
+	}
+
+	var r0 *untappd.User
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// InfoContext implements untappd.UserAPI.
+func (f *FakeUserAPI) InfoContext(ctx context.Context, username string, compact bool) (*untappd.User, *http.Response, error) {
+	f.record("InfoContext", ctx, username, compact)
+	if f.InfoContextFunc != nil {
+		return f.InfoContextFunc(ctx, username, compact)
+	}
+
+	var r0 *untappd.User
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// InfoMany implements untappd.UserAPI.
+func (f *FakeUserAPI) InfoMany(ctx context.Context, usernames []string, compact bool) ([]*untappd.User, error) {
+	f.record("InfoMany", ctx, usernames, compact)
+	if f.InfoManyFunc != nil {
+		return f.InfoManyFunc(ctx, usernames, compact)
+	}
+
+	var r0 []*untappd.User
+	var r1 error
+	return r0, r1
+}
+
+// AllWishList implements untappd.UserAPI.
+func (f *FakeUserAPI) AllWishList(ctx context.Context, username string, sort untappd.Sort) iter.Seq2[*untappd.Beer, error] {
+	f.record("AllWishList", ctx, username, sort)
+	if f.AllWishListFunc != nil {
+		return f.AllWishListFunc(ctx, username, sort)
+	}
+
+	return func(yield func(*untappd.Beer, error) bool) {}
+}
+
+// WishList implements untappd.UserAPI.
+func (f *FakeUserAPI) WishList(username string) ([]*untappd.Beer, *http.Response, error) {
+	f.record("WishList", username)
+	if f.WishListFunc != nil {
+		return f.WishListFunc(username)
+	}
+
+	var r0 []*untappd.Beer
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// WishListContext implements untappd.UserAPI.
+func (f *FakeUserAPI) WishListContext(ctx context.Context, username string) ([]*untappd.Beer, *http.Response, error) {
+	f.record("WishListContext", ctx, username)
+	if f.WishListContextFunc != nil {
+		return f.WishListContextFunc(ctx, username)
+	}
+
+	var r0 []*untappd.Beer
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// WishListOffsetLimitSort implements untappd.UserAPI.
+func (f *FakeUserAPI) WishListOffsetLimitSort(username string, offset int, limit int, sort untappd.Sort) ([]*untappd.Beer, *http.Response, error) {
+	f.record("WishListOffsetLimitSort", username, offset, limit, sort)
+	if f.WishListOffsetLimitSortFunc != nil {
+		return f.WishListOffsetLimitSortFunc(username, offset, limit, sort)
+	}
+
+	var r0 []*untappd.Beer
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// WishListOffsetLimitSortContext implements untappd.UserAPI.
+func (f *FakeUserAPI) WishListOffsetLimitSortContext(ctx context.Context, username string, offset int, limit int, sort untappd.Sort) ([]*untappd.Beer, *http.Response, error) {
+	f.record("WishListOffsetLimitSortContext", ctx, username, offset, limit, sort)
+	if f.WishListOffsetLimitSortContextFunc != nil {
+		return f.WishListOffsetLimitSortContextFunc(ctx, username, offset, limit, sort)
+	}
+
+	var r0 []*untappd.Beer
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeVenueAPI is a fake implementation of untappd.VenueAPI, which records each
+// method call and returns scripted responses.  If a method's Func field is
+// not set, the method returns zero values, or an empty iterator.
+type FakeVenueAPI struct {
+	AllCheckinsFunc                  func(ctx context.Context, id int) iter.Seq2[*untappd.Checkin, error]
+	CheckinsFunc                     func(id int) ([]*untappd.Checkin, *http.Response, error)
+	CheckinsContextFunc              func(ctx context.Context, id int) ([]*untappd.Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimitFunc        func(id int, minID int, maxID int, limit int) ([]*untappd.Checkin, *http.Response, error)
+	CheckinsMinMaxIDLimitContextFunc func(ctx context.Context, id int, minID int, maxID int, limit int) ([]*untappd.Checkin, *http.Response, error)
+	InfoFunc                         func(id int, compact bool) (*untappd.Venue, *http.Response, error)
+	InfoContextFunc                  func(ctx context.Context, id int, compact bool) (*untappd.Venue, *http.Response, error)
+	InfoManyFunc                     func(ctx context.Context, ids []int, compact bool) ([]*untappd.Venue, error)
+
+	calls
+}
+
+// AllCheckins implements untappd.VenueAPI.
+func (f *FakeVenueAPI) AllCheckins(ctx context.Context, id int) iter.Seq2[*untappd.Checkin, error] {
+	f.record("AllCheckins", ctx, id)
+	if f.AllCheckinsFunc != nil {
+		return f.AllCheckinsFunc(ctx, id)
+	}
+
+	return func(yield func(*untappd.Checkin, error) bool) {}
+}
+
+// Checkins implements untappd.VenueAPI.
+func (f *FakeVenueAPI) Checkins(id int) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("Checkins", id)
+	if f.CheckinsFunc != nil {
+		return f.CheckinsFunc(id)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CheckinsContext implements untappd.VenueAPI.
+func (f *FakeVenueAPI) CheckinsContext(ctx context.Context, id int) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("CheckinsContext", ctx, id)
+	if f.CheckinsContextFunc != nil {
+		return f.CheckinsContextFunc(ctx, id)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CheckinsMinMaxIDLimit implements untappd.VenueAPI.
+func (f *FakeVenueAPI) CheckinsMinMaxIDLimit(id int, minID int, maxID int, limit int) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("CheckinsMinMaxIDLimit", id, minID, maxID, limit)
+	if f.CheckinsMinMaxIDLimitFunc != nil {
+		return f.CheckinsMinMaxIDLimitFunc(id, minID, maxID, limit)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CheckinsMinMaxIDLimitContext implements untappd.VenueAPI.
+func (f *FakeVenueAPI) CheckinsMinMaxIDLimitContext(ctx context.Context, id int, minID int, maxID int, limit int) ([]*untappd.Checkin, *http.Response, error) {
+	f.record("CheckinsMinMaxIDLimitContext", ctx, id, minID, maxID, limit)
+	if f.CheckinsMinMaxIDLimitContextFunc != nil {
+		return f.CheckinsMinMaxIDLimitContextFunc(ctx, id, minID, maxID, limit)
+	}
+
+	var r0 []*untappd.Checkin
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Info implements untappd.VenueAPI.
+func (f *FakeVenueAPI) Info(id int, compact bool) (*untappd.Venue, *http.Response, error) {
+	f.record("Info", id, compact)
+	if f.InfoFunc != nil {
+		return f.InfoFunc(id, compact)
+	}
+
+	var r0 *untappd.Venue
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// InfoContext implements untappd.VenueAPI.
+func (f *FakeVenueAPI) InfoContext(ctx context.Context, id int, compact bool) (*untappd.Venue, *http.Response, error) {
+	f.record("InfoContext", ctx, id, compact)
+	if f.InfoContextFunc != nil {
+		return f.InfoContextFunc(ctx, id, compact)
+	}
+
+	var r0 *untappd.Venue
+	var r1 *http.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// InfoMany implements untappd.VenueAPI.
+func (f *FakeVenueAPI) InfoMany(ctx context.Context, ids []int, compact bool) ([]*untappd.Venue, error) {
+	f.record("InfoMany", ctx, ids, compact)
+	if f.InfoManyFunc != nil {
+		return f.InfoManyFunc(ctx, ids, compact)
+	}
+
+	var r0 []*untappd.Venue
+	var r1 error
+	return r0, r1
+}
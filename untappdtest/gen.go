@@ -0,0 +1,218 @@
+//go:build ignore
+
+// Command gen generates fakes.go, which contains a fake implementation of
+// each service interface in package untappd.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+)
+
+// imports maps package names used in service interfaces to import paths.
+var imports = map[string]string{
+	"context": "context",
+	"http":    "net/http",
+	"iter":    "iter",
+}
+
+func main() {
+	f, err := parser.ParseFile(token.NewFileSet(), "../api.go", nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	used := map[string]bool{"untappd": true}
+
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range gd.Specs {
+			ts := spec.(*ast.TypeSpec)
+			it, ok := ts.Type.(*ast.InterfaceType)
+			if !ok || !strings.HasSuffix(ts.Name.Name, "API") {
+				continue
+			}
+
+			genFake(&buf, ts.Name.Name, it, used)
+		}
+	}
+
+	var paths []string
+	for name := range used {
+		if name == "untappd" {
+			continue
+		}
+		paths = append(paths, imports[name])
+	}
+	sort.Strings(paths)
+
+	var out bytes.Buffer
+	fmt.Fprintln(&out, "// Code generated by gen.go; DO NOT EDIT.")
+	fmt.Fprintln(&out)
+	fmt.Fprintln(&out, "package untappdtest")
+	fmt.Fprintln(&out)
+	fmt.Fprintln(&out, "import (")
+	for _, p := range paths {
+		fmt.Fprintf(&out, "\t%q\n", p)
+	}
+	fmt.Fprintln(&out)
+	fmt.Fprintln(&out, "\t\"github.com/mdlayher/untappd\"")
+	fmt.Fprintln(&out, ")")
+	out.Write(buf.Bytes())
+
+	b, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatalf("failed to format fakes: %v\n%s", err, out.String())
+	}
+
+	if err := ioutil.WriteFile("fakes.go", b, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// genFake writes a fake implementation of the interface iface to buf.
+func genFake(buf *bytes.Buffer, iface string, it *ast.InterfaceType, used map[string]bool) {
+	fake := "Fake" + iface
+
+	fmt.Fprintf(buf, "\n// %s is a fake implementation of untappd.%s, which records each\n", fake, iface)
+	fmt.Fprintln(buf, "// method call and returns scripted responses.  If a method's Func field is")
+	fmt.Fprintln(buf, "// not set, the method returns zero values, or an empty iterator.")
+	fmt.Fprintf(buf, "type %s struct {\n", fake)
+	for _, m := range it.Methods.List {
+		name := m.Names[0].Name
+		params, _, results := signature(m.Type.(*ast.FuncType), used)
+
+		fmt.Fprintf(buf, "\t%sFunc func(%s) %s\n", name, params, results)
+	}
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "\tcalls")
+	fmt.Fprintln(buf, "}")
+
+	for _, m := range it.Methods.List {
+		name := m.Names[0].Name
+		ft := m.Type.(*ast.FuncType)
+		params, args, results := signature(ft, used)
+
+		fmt.Fprintf(buf, "\n// %s implements untappd.%s.\n", name, iface)
+		fmt.Fprintf(buf, "func (f *%s) %s(%s) %s {\n", fake, name, params, results)
+		if args == "" {
+			fmt.Fprintf(buf, "\tf.record(%q)\n", name)
+		} else {
+			fmt.Fprintf(buf, "\tf.record(%q, %s)\n", name, args)
+		}
+		fmt.Fprintf(buf, "\tif f.%sFunc != nil {\n", name)
+		fmt.Fprintf(buf, "\t\treturn f.%sFunc(%s)\n", name, args)
+		fmt.Fprintln(buf, "\t}")
+		fmt.Fprintln(buf)
+
+		// Iterators must be non-nil to be ranged over, so an empty one is
+		// returned instead
+		rt := ft.Results.List
+		if len(rt) == 1 && strings.HasPrefix(typeString(rt[0].Type, used), "iter.") {
+			fmt.Fprintf(buf, "\treturn %s {}\n", iterFunc(rt[0].Type, used))
+			fmt.Fprintln(buf, "}")
+			continue
+		}
+
+		var zeros []string
+		for i, r := range rt {
+			fmt.Fprintf(buf, "\tvar r%d %s\n", i, typeString(r.Type, used))
+			zeros = append(zeros, fmt.Sprintf("r%d", i))
+		}
+		fmt.Fprintf(buf, "\treturn %s\n", strings.Join(zeros, ", "))
+		fmt.Fprintln(buf, "}")
+	}
+}
+
+// signature returns the parameter list, argument list, and result list of
+// ft, as Go source.
+func signature(ft *ast.FuncType, used map[string]bool) (string, string, string) {
+	var params, args []string
+	for _, p := range ft.Params.List {
+		t := typeString(p.Type, used)
+		for _, n := range p.Names {
+			params = append(params, n.Name+" "+t)
+			args = append(args, n.Name)
+		}
+	}
+
+	var results []string
+	for _, r := range ft.Results.List {
+		results = append(results, typeString(r.Type, used))
+	}
+
+	rs := strings.Join(results, ", ")
+	if len(results) > 1 {
+		rs = "(" + rs + ")"
+	}
+
+	return strings.Join(params, ", "), strings.Join(args, ", "), rs
+}
+
+// iterFunc returns the function type underlying the iterator type e, such as
+// "func(yield func(*untappd.Beer, error) bool)" for iter.Seq2[*Beer, error].
+func iterFunc(e ast.Expr, used map[string]bool) string {
+	var types []string
+	switch t := e.(type) {
+	case *ast.IndexExpr:
+		types = append(types, typeString(t.Index, used))
+	case *ast.IndexListExpr:
+		for _, i := range t.Indices {
+			types = append(types, typeString(i, used))
+		}
+	default:
+		log.Fatalf("unsupported iterator type: %T", e)
+	}
+
+	return fmt.Sprintf("func(yield func(%s) bool)", strings.Join(types, ", "))
+}
+
+// typeString returns the Go source for type e, qualifying types declared in
+// package untappd.  Each package referenced is added to used.
+func typeString(e ast.Expr, used map[string]bool) string {
+	switch t := e.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return "untappd." + t.Name
+		}
+		return t.Name
+	case *ast.StarExpr:
+		return "*" + typeString(t.X, used)
+	case *ast.ArrayType:
+		if t.Len != nil {
+			log.Fatal("unsupported array type")
+		}
+		return "[]" + typeString(t.Elt, used)
+	case *ast.SelectorExpr:
+		pkg := t.X.(*ast.Ident).Name
+		if _, ok := imports[pkg]; !ok {
+			log.Fatalf("unknown package: %q", pkg)
+		}
+		used[pkg] = true
+		return pkg + "." + t.Sel.Name
+	case *ast.IndexExpr:
+		return typeString(t.X, used) + "[" + typeString(t.Index, used) + "]"
+	case *ast.IndexListExpr:
+		var indices []string
+		for _, i := range t.Indices {
+			indices = append(indices, typeString(i, used))
+		}
+		return typeString(t.X, used) + "[" + strings.Join(indices, ", ") + "]"
+	}
+
+	log.Fatalf("unsupported type: %T", e)
+	return ""
+}
@@ -0,0 +1,787 @@
+package untappdtest
+
+import (
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mdlayher/untappd"
+)
+
+var (
+	// Errors returned by a Server, in the same form as the Untappd APIv4.
+	errInvalidAuth     = apiError(http.StatusInternalServerError, "invalid_auth", "The user has not authorized this application or the token is invalid.")
+	errInvalidUser     = apiError(http.StatusInternalServerError, "invalid_auth", "There is no user with that username.")
+	errInvalidBeer     = errInvalidParam("This Beer ID is invalid.")
+	errInvalidBrewery  = errInvalidParam("This Brewery ID is invalid.")
+	errInvalidVenue    = errInvalidParam("This Venue ID is invalid.")
+	errInvalidLocal    = errInvalidParam("Your missing the 'lat' or 'lng' parameter.")
+	errInvalidQuery    = errInvalidParam("Your missing the 'q' parameter.")
+	errInvalidCheckin  = errInvalidParam("The bid field is required.")
+	errInvalidEndpoint = apiError(http.StatusNotFound, "invalid_endpoint", "This endpoint does not exist.")
+	errInvalidMethod   = apiError(http.StatusMethodNotAllowed, "invalid_method", "This HTTP method is not allowed for this endpoint.")
+	errRateLimit       = apiError(http.StatusTooManyRequests, "invalid_limit", "You have exceeded the allowed rate limit.")
+)
+
+// apiError creates an untappd.Error with the specified code, type, and detail.
+func apiError(code int, typ string, detail string) *untappd.Error {
+	return &untappd.Error{
+		Code:   code,
+		Type:   typ,
+		Detail: detail,
+	}
+}
+
+// errInvalidParam creates an untappd.Error for an invalid parameter.
+func errInvalidParam(detail string) *untappd.Error {
+	return apiError(http.StatusInternalServerError, "invalid_param", detail)
+}
+
+// A request is an API request made to a Server.
+type request struct {
+	form     url.Values
+	username string
+	arg      string
+}
+
+// route invokes the handler for endpoint.  s.mu must be held.
+func (s *Server) route(method string, endpoint string, r *request) (interface{}, *untappd.Error) {
+	// Most endpoints accept a single ID or username argument after the
+	// endpoint name, such as "beer/info/1"
+	name := endpoint
+	if parts := strings.Split(endpoint, "/"); len(parts) == 3 {
+		name = parts[0] + "/" + parts[1]
+		r.arg = parts[2]
+	}
+
+	handlers := map[string]func(r *request) (interface{}, *untappd.Error){
+		"beer/info":         s.beerInfo,
+		"beer/checkins":     s.beerCheckins,
+		"brewery/info":      s.breweryInfo,
+		"brewery/checkins":  s.breweryCheckins,
+		"brewery/beer_list": s.breweryBeers,
+		"venue/info":        s.venueInfo,
+		"venue/checkins":    s.venueCheckins,
+		"user/info":         s.userInfo,
+		"user/checkins":     s.userCheckins,
+		"user/badges":       s.userBadges,
+		"user/friends":      s.userFriends,
+		"user/beers":        s.userBeers,
+		"user/wishlist":     s.userWishList,
+		"search/beer":       s.searchBeer,
+		"search/brewery":    s.searchBrewery,
+		"thepub/local":      s.localCheckins,
+		"checkin/recent":    s.recentCheckins,
+		"checkin/add":       s.addCheckin,
+	}
+
+	fn, ok := handlers[name]
+	if !ok {
+		return nil, errInvalidEndpoint
+	}
+
+	want := "GET"
+	if name == "checkin/add" {
+		want = "POST"
+	}
+	if method != want {
+		return nil, errInvalidMethod
+	}
+
+	return fn(r)
+}
+
+// beerInfo implements the beer/info endpoint.
+func (s *Server) beerInfo(r *request) (interface{}, *untappd.Error) {
+	b, ok := s.beer(r.arg)
+	if !ok {
+		return nil, errInvalidBeer
+	}
+
+	o := encodeBeer(b)
+	o["brewery"] = encodeBrewery(s.beerBrewery(b))
+
+	if r.form.Get("compact") != "true" {
+		similar := make([]interface{}, 0, len(b.Similar))
+		for _, sb := range b.Similar {
+			similar = append(similar, object{
+				"rating_score": sb.OverallRating,
+				"beer":         encodeBeer(sb),
+				"brewery":      encodeBrewery(s.beerBrewery(sb)),
+			})
+		}
+		o["similar"] = list(similar)
+
+		vintages := make([]interface{}, 0, len(b.Vintages))
+		for _, vb := range b.Vintages {
+			vintages = append(vintages, object{
+				"beer": encodeBeer(vb),
+			})
+		}
+		o["vintages"] = list(vintages)
+	}
+
+	return object{"beer": o}, nil
+}
+
+// beerCheckins implements the beer/checkins endpoint.
+func (s *Server) beerCheckins(r *request) (interface{}, *untappd.Error) {
+	b, ok := s.beer(r.arg)
+	if !ok {
+		return nil, errInvalidBeer
+	}
+
+	return s.checkins(r, func(c *untappd.Checkin) bool {
+		return c.Beer != nil && c.Beer.ID == b.ID
+	}), nil
+}
+
+// breweryInfo implements the brewery/info endpoint.
+func (s *Server) breweryInfo(r *request) (interface{}, *untappd.Error) {
+	b, ok := s.brewery(r.arg)
+	if !ok {
+		return nil, errInvalidBrewery
+	}
+
+	o := encodeBrewery(b)
+
+	if r.form.Get("compact") != "true" {
+		var beers []interface{}
+		for _, beer := range s.breweryBeerList(b) {
+			beers = append(beers, object{
+				"beer":    encodeBeer(beer),
+				"brewery": encodeBrewery(b),
+			})
+		}
+		o["beer_list"] = list(beers)
+
+		o["checkins"] = encodeCheckins(s.latestCheckins(func(c *untappd.Checkin) bool {
+			return checkinBreweryID(c) == b.ID
+		}))
+	}
+
+	return object{"brewery": o}, nil
+}
+
+// breweryCheckins implements the brewery/checkins endpoint.
+func (s *Server) breweryCheckins(r *request) (interface{}, *untappd.Error) {
+	b, ok := s.brewery(r.arg)
+	if !ok {
+		return nil, errInvalidBrewery
+	}
+
+	return s.checkins(r, func(c *untappd.Checkin) bool {
+		return checkinBreweryID(c) == b.ID
+	}), nil
+}
+
+// breweryBeers implements the brewery/beer_list endpoint.
+func (s *Server) breweryBeers(r *request) (interface{}, *untappd.Error) {
+	b, ok := s.brewery(r.arg)
+	if !ok {
+		return nil, errInvalidBrewery
+	}
+
+	beers := s.breweryBeerList(b)
+	sortBeers(beers, untappd.Sort(r.form.Get("sort")))
+
+	var items []interface{}
+	for _, beer := range paginate(beers, r.form) {
+		items = append(items, object{
+			"has_had": false,
+			"beer":    encodeBeer(beer),
+			"brewery": encodeBrewery(b),
+		})
+	}
+
+	return object{
+		"brewery": encodeBrewery(b),
+		"beers":   list(items),
+	}, nil
+}
+
+// venueInfo implements the venue/info endpoint.
+func (s *Server) venueInfo(r *request) (interface{}, *untappd.Error) {
+	v, ok := s.venue(r.arg)
+	if !ok {
+		return nil, errInvalidVenue
+	}
+
+	o := encodeVenue(v).(object)
+
+	if r.form.Get("compact") != "true" {
+		beers := make([]interface{}, 0, len(v.TopBeers))
+		for _, b := range v.TopBeers {
+			beers = append(beers, object{
+				"created_at":  formatTime(b.RecentHad),
+				"total_count": b.Count,
+				"your_count":  0,
+				"beer":        encodeBeer(b),
+				"brewery":     encodeBrewery(s.beerBrewery(b)),
+			})
+		}
+		o["top_beers"] = list(beers)
+
+		o["checkins"] = encodeCheckins(s.latestCheckins(func(c *untappd.Checkin) bool {
+			return c.Venue != nil && c.Venue.ID == v.ID
+		}))
+	}
+
+	return object{"venue": o}, nil
+}
+
+// venueCheckins implements the venue/checkins endpoint.
+func (s *Server) venueCheckins(r *request) (interface{}, *untappd.Error) {
+	v, ok := s.venue(r.arg)
+	if !ok {
+		return nil, errInvalidVenue
+	}
+
+	return s.checkins(r, func(c *untappd.Checkin) bool {
+		return c.Venue != nil && c.Venue.ID == v.ID
+	}), nil
+}
+
+// userInfo implements the user/info endpoint.
+func (s *Server) userInfo(r *request) (interface{}, *untappd.Error) {
+	u, ok := s.user(r.arg)
+	if !ok {
+		return nil, errInvalidUser
+	}
+
+	o := encodeUser(u)
+
+	if r.form.Get("compact") != "true" {
+		checkins := s.latestCheckins(func(c *untappd.Checkin) bool {
+			return checkinUserName(c) == u.UserName
+		})
+		o["checkins"] = encodeCheckins(checkins)
+
+		var beers []interface{}
+		seen := make(map[int]bool)
+		for _, c := range checkins {
+			if c.Beer == nil || seen[c.Beer.ID] {
+				continue
+			}
+			seen[c.Beer.ID] = true
+
+			beers = append(beers, object{
+				"beer":    encodeBeer(c.Beer),
+				"brewery": encodeBrewery(s.checkinBrewery(c)),
+			})
+		}
+		o["recent_brews"] = list(beers)
+
+		var badges []interface{}
+		for _, b := range s.seed.Badges[u.UserName] {
+			badges = append(badges, encodeBadge(b))
+		}
+		o["badges"] = list(badges)
+	}
+
+	return object{"user": o}, nil
+}
+
+// userCheckins implements the user/checkins endpoint.
+func (s *Server) userCheckins(r *request) (interface{}, *untappd.Error) {
+	u, ok := s.user(r.arg)
+	if !ok {
+		return nil, errInvalidUser
+	}
+
+	return s.checkins(r, func(c *untappd.Checkin) bool {
+		return checkinUserName(c) == u.UserName
+	}), nil
+}
+
+// userBadges implements the user/badges endpoint.
+func (s *Server) userBadges(r *request) (interface{}, *untappd.Error) {
+	u, ok := s.user(r.arg)
+	if !ok {
+		return nil, errInvalidUser
+	}
+
+	var items []interface{}
+	for _, b := range paginate(s.seed.Badges[u.UserName], r.form) {
+		items = append(items, encodeBadge(b))
+	}
+
+	return list(items), nil
+}
+
+// userFriends implements the user/friends endpoint.
+func (s *Server) userFriends(r *request) (interface{}, *untappd.Error) {
+	u, ok := s.user(r.arg)
+	if !ok {
+		return nil, errInvalidUser
+	}
+
+	var items []interface{}
+	for _, name := range paginate(s.seed.Friends[u.UserName], r.form) {
+		friend, ok := s.user(name)
+		if !ok {
+			friend = &untappd.User{UserName: name}
+		}
+
+		items = append(items, object{
+			"user": encodeUser(friend),
+		})
+	}
+
+	return list(items), nil
+}
+
+// userBeers implements the user/beers endpoint.  Each beer is derived from
+// the user's checkins.
+func (s *Server) userBeers(r *request) (interface{}, *untappd.Error) {
+	u, ok := s.user(r.arg)
+	if !ok {
+		return nil, errInvalidUser
+	}
+
+	// Checkins are ordered newest first, so the first checkin of each beer
+	// is the most recent one
+	var beers []*untappd.Beer
+	byID := make(map[int]*untappd.Beer)
+	for _, c := range s.seed.Checkins {
+		if c.Beer == nil || checkinUserName(c) != u.UserName {
+			continue
+		}
+
+		b, ok := byID[c.Beer.ID]
+		if !ok {
+			bb := *c.Beer
+			bb.Brewery = s.checkinBrewery(c)
+			bb.RecentHad = c.Created
+			bb.UserRating = c.UserRating
+
+			b = &bb
+			byID[b.ID] = b
+			beers = append(beers, b)
+		}
+
+		b.FirstHad = c.Created
+		b.Count++
+	}
+
+	sortBeers(beers, untappd.Sort(r.form.Get("sort")))
+
+	var items []interface{}
+	for _, b := range paginate(beers, r.form) {
+		items = append(items, object{
+			"first_created_at":  formatTime(b.FirstHad),
+			"recent_created_at": formatTime(b.RecentHad),
+			"rating_score":      b.UserRating,
+			"count":             b.Count,
+			"beer":              encodeBeer(b),
+			"brewery":           encodeBrewery(b.Brewery),
+		})
+	}
+
+	return object{"beers": list(items)}, nil
+}
+
+// userWishList implements the user/wishlist endpoint.
+func (s *Server) userWishList(r *request) (interface{}, *untappd.Error) {
+	u, ok := s.user(r.arg)
+	if !ok {
+		return nil, errInvalidUser
+	}
+
+	beers := append([]*untappd.Beer(nil), s.seed.WishLists[u.UserName]...)
+	sortBeers(beers, untappd.Sort(r.form.Get("sort")))
+
+	var items []interface{}
+	for _, b := range paginate(beers, r.form) {
+		items = append(items, object{
+			"created_at": formatTime(b.WishListed),
+			"beer":       encodeBeer(b),
+			"brewery":    encodeBrewery(s.beerBrewery(b)),
+		})
+	}
+
+	return object{"beers": list(items)}, nil
+}
+
+// searchBeer implements the search/beer endpoint.  A beer matches if each
+// word of the query appears in its brewery's name or its own name.
+func (s *Server) searchBeer(r *request) (interface{}, *untappd.Error) {
+	q := r.form.Get("q")
+	if q == "" {
+		return nil, errInvalidQuery
+	}
+
+	var beers []*untappd.Beer
+	for _, b := range s.seed.Beers {
+		if matches(q, s.beerBrewery(b).Name+" "+b.Name) {
+			beers = append(beers, b)
+		}
+	}
+
+	sortBeers(beers, untappd.Sort(r.form.Get("sort")))
+
+	var items []interface{}
+	for _, b := range paginate(beers, r.form) {
+		items = append(items, object{
+			"checkin_count": b.Stats.TotalCount,
+			"beer":          encodeBeer(b),
+			"brewery":       encodeBrewery(s.beerBrewery(b)),
+		})
+	}
+
+	return object{"beers": list(items)}, nil
+}
+
+// searchBrewery implements the search/brewery endpoint.  A brewery matches
+// if each word of the query appears in its name.
+func (s *Server) searchBrewery(r *request) (interface{}, *untappd.Error) {
+	q := r.form.Get("q")
+	if q == "" {
+		return nil, errInvalidQuery
+	}
+
+	var breweries []*untappd.Brewery
+	for _, b := range s.seed.Breweries {
+		if matches(q, b.Name) {
+			breweries = append(breweries, b)
+		}
+	}
+
+	var items []interface{}
+	for _, b := range paginate(breweries, r.form) {
+		items = append(items, object{
+			"brewery": encodeBrewery(b),
+		})
+	}
+
+	return object{"brewery": list(items)}, nil
+}
+
+// localCheckins implements the thepub/local endpoint.  A checkin matches if
+// its venue is within the requested radius of the requested location.
+func (s *Server) localCheckins(r *request) (interface{}, *untappd.Error) {
+	lat, err := strconv.ParseFloat(r.form.Get("lat"), 64)
+	if err != nil {
+		return nil, errInvalidLocal
+	}
+	lng, err := strconv.ParseFloat(r.form.Get("lng"), 64)
+	if err != nil {
+		return nil, errInvalidLocal
+	}
+
+	radius := float64(intParam(r.form, "radius", 25))
+	if untappd.Distance(r.form.Get("dist_pref")) == untappd.DistanceKilometers {
+		radius /= 1.609344
+	}
+
+	return s.checkins(r, func(c *untappd.Checkin) bool {
+		if c.Venue == nil {
+			return false
+		}
+
+		l := c.Venue.Location
+		return distanceMiles(lat, lng, l.Latitude, l.Longitude) <= radius
+	}), nil
+}
+
+// recentCheckins implements the checkin/recent endpoint, which returns
+// checkins by friends of the authenticated user.
+func (s *Server) recentCheckins(r *request) (interface{}, *untappd.Error) {
+	if r.username == "" {
+		return nil, errInvalidAuth
+	}
+
+	friends := make(map[string]bool)
+	for _, name := range s.seed.Friends[r.username] {
+		friends[name] = true
+	}
+
+	return s.checkins(r, func(c *untappd.Checkin) bool {
+		return friends[checkinUserName(c)]
+	}), nil
+}
+
+// addCheckin implements the checkin/add endpoint, which checks in a beer as
+// the authenticated user.
+func (s *Server) addCheckin(r *request) (interface{}, *untappd.Error) {
+	if r.username == "" {
+		return nil, errInvalidAuth
+	}
+
+	if r.form.Get("bid") == "" {
+		return nil, errInvalidCheckin
+	}
+	b, ok := s.beer(r.form.Get("bid"))
+	if !ok {
+		return nil, errInvalidBeer
+	}
+
+	u, ok := s.user(r.username)
+	if !ok {
+		u = &untappd.User{UserName: r.username}
+	}
+
+	rating, _ := strconv.ParseFloat(r.form.Get("rating"), 64)
+
+	c := &untappd.Checkin{
+		ID:         1,
+		Created:    time.Now(),
+		Comment:    r.form.Get("shout"),
+		UserRating: rating,
+		User:       u,
+		Beer:       b,
+		Brewery:    s.beerBrewery(b),
+	}
+	if len(s.seed.Checkins) > 0 {
+		c.ID = s.seed.Checkins[0].ID + 1
+	}
+
+	s.seed.Checkins = append([]*untappd.Checkin{c}, s.seed.Checkins...)
+
+	return encodeCheckin(c), nil
+}
+
+// checkins returns a list of the checkins which match fn, paged using the
+// min_id, max_id, and limit parameters of r.
+func (s *Server) checkins(r *request, fn func(c *untappd.Checkin) bool) object {
+	minID := intParam(r.form, "min_id", 0)
+	maxID := intParam(r.form, "max_id", math.MaxInt32)
+	limit := limitParam(r.form)
+
+	var checkins []*untappd.Checkin
+	for _, c := range s.seed.Checkins {
+		if len(checkins) == limit {
+			break
+		}
+
+		if c.ID > minID && c.ID <= maxID && fn(c) {
+			checkins = append(checkins, c)
+		}
+	}
+
+	return object{"checkins": encodeCheckins(checkins)}
+}
+
+// latestCheckins returns up to 25 of the most recent checkins which match fn,
+// as included in info endpoint responses.
+func (s *Server) latestCheckins(fn func(c *untappd.Checkin) bool) []*untappd.Checkin {
+	var checkins []*untappd.Checkin
+	for _, c := range s.seed.Checkins {
+		if len(checkins) == 25 {
+			break
+		}
+
+		if fn(c) {
+			checkins = append(checkins, c)
+		}
+	}
+
+	return checkins
+}
+
+// beer finds a seeded beer by its ID.
+func (s *Server) beer(id string) (*untappd.Beer, bool) {
+	for _, b := range s.seed.Beers {
+		if strconv.Itoa(b.ID) == id {
+			return b, true
+		}
+	}
+
+	return nil, false
+}
+
+// brewery finds a seeded brewery by its ID.
+func (s *Server) brewery(id string) (*untappd.Brewery, bool) {
+	for _, b := range s.seed.Breweries {
+		if strconv.Itoa(b.ID) == id {
+			return b, true
+		}
+	}
+
+	return nil, false
+}
+
+// venue finds a seeded venue by its ID.
+func (s *Server) venue(id string) (*untappd.Venue, bool) {
+	for _, v := range s.seed.Venues {
+		if strconv.Itoa(v.ID) == id {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
+
+// user finds a seeded user by username, ignoring case.
+func (s *Server) user(username string) (*untappd.User, bool) {
+	for _, u := range s.seed.Users {
+		if strings.EqualFold(u.UserName, username) {
+			return u, true
+		}
+	}
+
+	return nil, false
+}
+
+// beerBrewery returns the brewery which made b, preferring the seeded
+// brewery with the same ID.
+func (s *Server) beerBrewery(b *untappd.Beer) *untappd.Brewery {
+	if b.Brewery == nil {
+		return nil
+	}
+
+	if sb, ok := s.brewery(strconv.Itoa(b.Brewery.ID)); ok {
+		return sb
+	}
+
+	return b.Brewery
+}
+
+// checkinBrewery returns the brewery which made the beer checked in by c.
+func (s *Server) checkinBrewery(c *untappd.Checkin) *untappd.Brewery {
+	if c.Brewery != nil {
+		return c.Brewery
+	}
+	if c.Beer != nil {
+		return s.beerBrewery(c.Beer)
+	}
+
+	return nil
+}
+
+// breweryBeerList returns all seeded beers made by b.
+func (s *Server) breweryBeerList(b *untappd.Brewery) []*untappd.Beer {
+	var beers []*untappd.Beer
+	for _, beer := range s.seed.Beers {
+		if beer.Brewery != nil && beer.Brewery.ID == b.ID {
+			beers = append(beers, beer)
+		}
+	}
+
+	return beers
+}
+
+// checkinBreweryID returns the ID of the brewery which made the beer
+// checked in by c.
+func checkinBreweryID(c *untappd.Checkin) int {
+	switch {
+	case c.Brewery != nil:
+		return c.Brewery.ID
+	case c.Beer != nil && c.Beer.Brewery != nil:
+		return c.Beer.Brewery.ID
+	}
+
+	return 0
+}
+
+// checkinUserName returns the username of the user who submitted c.
+func checkinUserName(c *untappd.Checkin) string {
+	if c.User == nil {
+		return ""
+	}
+
+	return c.User.UserName
+}
+
+// sortBeers sorts beers in place, using the same ordering as the Untappd
+// APIv4 for the sort parameter by.  Unrecognized sorts leave beers unchanged.
+func sortBeers(beers []*untappd.Beer, by untappd.Sort) {
+	var less func(a *untappd.Beer, b *untappd.Beer) bool
+	switch by {
+	case untappd.SortDate:
+		less = func(a *untappd.Beer, b *untappd.Beer) bool { return a.RecentHad.After(b.RecentHad) }
+	case untappd.SortCheckin:
+		less = func(a *untappd.Beer, b *untappd.Beer) bool { return a.Count > b.Count }
+	case untappd.SortHighestRated:
+		less = func(a *untappd.Beer, b *untappd.Beer) bool { return a.OverallRating > b.OverallRating }
+	case untappd.SortLowestRated:
+		less = func(a *untappd.Beer, b *untappd.Beer) bool { return a.OverallRating < b.OverallRating }
+	case untappd.SortUserHighestRated:
+		less = func(a *untappd.Beer, b *untappd.Beer) bool { return a.UserRating > b.UserRating }
+	case untappd.SortUserLowestRated:
+		less = func(a *untappd.Beer, b *untappd.Beer) bool { return a.UserRating < b.UserRating }
+	case untappd.SortHighestABV:
+		less = func(a *untappd.Beer, b *untappd.Beer) bool { return a.ABV > b.ABV }
+	case untappd.SortLowestABV:
+		less = func(a *untappd.Beer, b *untappd.Beer) bool { return a.ABV < b.ABV }
+	default:
+		return
+	}
+
+	sort.SliceStable(beers, func(i int, j int) bool {
+		return less(beers[i], beers[j])
+	})
+}
+
+// paginate returns the page of items selected by the offset and limit
+// parameters in form.
+func paginate[T any](items []T, form url.Values) []T {
+	offset := intParam(form, "offset", 0)
+	if offset < 0 || offset >= len(items) {
+		return nil
+	}
+
+	items = items[offset:]
+	if limit := limitParam(form); len(items) > limit {
+		items = items[:limit]
+	}
+
+	return items
+}
+
+// limitParam returns the limit parameter in form.  As with the Untappd APIv4,
+// 25 items are returned by default, and at most 50 items may be returned.
+func limitParam(form url.Values) int {
+	limit := intParam(form, "limit", 25)
+	if limit <= 0 {
+		return 25
+	}
+	if limit > 50 {
+		return 50
+	}
+
+	return limit
+}
+
+// intParam returns the integer parameter key in form, or def if it is not
+// set or is not an integer.
+func intParam(form url.Values, key string, def int) int {
+	v, err := strconv.Atoi(form.Get(key))
+	if err != nil {
+		return def
+	}
+
+	return v
+}
+
+// matches reports whether each word in query appears in s, ignoring case.
+func matches(query string, s string) bool {
+	s = strings.ToLower(s)
+	for _, word := range strings.Fields(strings.ToLower(query)) {
+		if !strings.Contains(s, word) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// distanceMiles returns the great-circle distance in miles between two
+// latitude and longitude pairs.
+func distanceMiles(lat1 float64, lng1 float64, lat2 float64, lng2 float64) float64 {
+	const earthRadiusMiles = 3958.8
+
+	rad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := rad(lat2 - lat1)
+	dLng := rad(lng2 - lng1)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(rad(lat1))*math.Cos(rad(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return 2 * earthRadiusMiles * math.Asin(math.Sqrt(a))
+}
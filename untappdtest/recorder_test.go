@@ -0,0 +1,134 @@
+package untappdtest
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mdlayher/untappd"
+)
+
+// TestRecorderRecordReplay verifies that a Recorder records interactions to
+// a cassette without secrets, and replays them without network access.
+func TestRecorderRecordReplay(t *testing.T) {
+	s := NewServer(testSeed())
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	rec, err := NewRecorder(path, ModeRecord, s.HTTPClient().Transport)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Use the default base URL, so that the cassette contains real
+	// Untappd APIv4 URLs
+	token := s.Token("mdlayher")
+	c, err := untappd.NewAuthenticatedClient(token, rec.HTTPClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	beer, _, err := c.Beer.Info(1, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := c.Auth.Checkin(untappd.CheckinRequest{BeerID: 2, Comment: "tasty"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := rec.Close(); err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{token, ClientID, ClientSecret} {
+		if strings.Contains(string(b), secret) {
+			t.Fatalf("cassette contains secret %q:\n%s", secret, string(b))
+		}
+	}
+	if !strings.Contains(string(b), "https://api.untappd.com/v4/beer/info/1/") {
+		t.Fatalf("cassette does not contain request URL:\n%s", string(b))
+	}
+
+	// Replay using a different token, which should be ignored for matching
+	rep, err := NewRecorder(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err = untappd.NewAuthenticatedClient("foo", rep.HTTPClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rbeer, _, err := c.Beer.Info(1, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rbeer.ID != beer.ID || rbeer.Name != beer.Name {
+		t.Fatalf("unexpected replayed beer: %v != %v", rbeer, beer)
+	}
+
+	checkin, _, err := c.Auth.Checkin(untappd.CheckinRequest{BeerID: 2, Comment: "tasty"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if checkin.Comment != "tasty" {
+		t.Fatalf("unexpected replayed checkin comment: %q != %q", checkin.Comment, "tasty")
+	}
+
+	// Non-secret parameters must match
+	_, _, err = c.Beer.Info(1, false)
+	if !errors.Is(err, ErrNoInteraction) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// TestRecorderScrubsAccessTokenResponse verifies that a Recorder scrubs
+// access tokens from OAuth responses.
+func TestRecorderScrubsAccessTokenResponse(t *testing.T) {
+	s := NewServer(testSeed())
+	defer s.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	rec, err := NewRecorder(path, ModeRecord, s.HTTPClient().Transport)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Obtain an authorization code, and exchange it for a token
+	s.mu.Lock()
+	s.codes["foo"] = "mdlayher"
+	s.mu.Unlock()
+
+	res, err := rec.HTTPClient().Get("https://untappd.com/oauth/authorize/?client_id=" +
+		ClientID + "&client_secret=" + ClientSecret + "&response_type=code&code=foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(body), "untappdtest-token-") {
+		t.Fatalf("response does not contain token: %s", string(body))
+	}
+
+	if err := rec.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "untappdtest-token-") {
+		t.Fatalf("cassette contains access token:\n%s", string(b))
+	}
+}
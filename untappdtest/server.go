@@ -0,0 +1,432 @@
+// Package untappdtest provides an in-memory fake Untappd APIv4 server, for
+// use in tests of packages which use package untappd.
+//
+// A Server is seeded with beers, breweries, venues, users, and checkins, and
+// serves them from the same endpoints, and in the same JSON format, as the
+// Untappd APIv4.  Paging parameters, errors for unknown entities, rate limit
+// headers, and the OAuth authentication flow used by untappd.AuthHandler are
+// all supported.  Photos are not served.
+//
+// A Server is typically used with a Client created by Server.Client or
+// Server.AuthenticatedClient, but any untappd.Client may be pointed at a
+// Server using untappd.Client.SetBaseURL.
+//
+// A Recorder records interactions with the real Untappd APIv4 to a cassette
+// file, with secrets scrubbed, and replays them later without network access.
+//
+// For tests which need no HTTP at all, fake implementations of each service
+// interface, such as FakeBeerAPI, record each call and return scripted
+// responses.  They may be assigned directly to a Client's service fields.
+package untappdtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mdlayher/untappd"
+)
+
+const (
+	// ClientID and ClientSecret are the only client credentials accepted by
+	// a Server.
+	ClientID     = "untappdtest-client-id"
+	ClientSecret = "untappdtest-client-secret"
+
+	// DefaultRateLimit is the number of API requests allowed by a Server,
+	// unless changed using SetRateLimit.  This is the same as the hourly
+	// rate limit of the Untappd APIv4.
+	DefaultRateLimit = 100
+)
+
+// Seed is the data served by a Server.  Relationships between entities are
+// determined by their IDs and usernames: for example, a beer's checkins are
+// all seeded checkins whose Beer has the same ID, and a brewery's beers are
+// all seeded beers whose Brewery has the same ID.
+type Seed struct {
+	Beers     []*untappd.Beer
+	Breweries []*untappd.Brewery
+	Venues    []*untappd.Venue
+	Users     []*untappd.User
+	Checkins  []*untappd.Checkin
+
+	// Badges earned by each user, keyed by username.
+	Badges map[string][]*untappd.Badge
+
+	// Usernames of each user's friends, keyed by username.
+	Friends map[string][]string
+
+	// Beers in each user's wish list, keyed by username.  Each Beer's
+	// WishListed field is reported as the time it was added.
+	WishLists map[string][]*untappd.Beer
+
+	// The username of the user who approves OAuth authentication requests.
+	// If empty, the first seeded user is used.
+	AuthUser string
+}
+
+// A Server is a fake Untappd APIv4 server.  Its methods are safe for
+// concurrent use.
+type Server struct {
+	// URL is the base URL of the fake Untappd APIv4, for use with
+	// untappd.Client.SetBaseURL.
+	URL string
+
+	srv *httptest.Server
+
+	mu        sync.Mutex
+	seed      Seed
+	tokens    map[string]string
+	codes     map[string]string
+	errors    map[string]*untappd.Error
+	limit     int
+	remaining int
+}
+
+// NewServer starts and returns a new Server, which serves the data in seed.
+// The caller should call Close when finished, to shut it down.
+//
+// Checkins added by untappd.AuthService.Checkin are added to the Server's
+// data, but seed itself is never modified.
+func NewServer(seed Seed) *Server {
+	// Checkins are always served newest first
+	seed.Checkins = append([]*untappd.Checkin(nil), seed.Checkins...)
+	sort.SliceStable(seed.Checkins, func(i int, j int) bool {
+		return seed.Checkins[i].ID > seed.Checkins[j].ID
+	})
+
+	s := &Server{
+		seed:      seed,
+		tokens:    make(map[string]string),
+		codes:     make(map[string]string),
+		errors:    make(map[string]*untappd.Error),
+		limit:     DefaultRateLimit,
+		remaining: DefaultRateLimit,
+	}
+
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.srv.URL + "/v4"
+
+	return s
+}
+
+// Close shuts down the Server.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// HTTPClient returns a http.Client which sends requests for the Untappd
+// APIv4 and OAuth hosts to the Server.  This enables use of the Server by
+// types which do not accept a base URL, such as untappd.AuthHandler.
+func (s *Server) HTTPClient() *http.Client {
+	return &http.Client{
+		Transport: &transport{
+			host: s.srv.Listener.Addr().String(),
+			rt:   s.srv.Client().Transport,
+		},
+	}
+}
+
+// Client returns an untappd.Client which is configured to make
+// unauthenticated requests to the Server.
+func (s *Server) Client() *untappd.Client {
+	c, err := untappd.NewClient(ClientID, ClientSecret, s.HTTPClient())
+	if err != nil {
+		panic("untappdtest: failed to create client: " + err.Error())
+	}
+
+	return s.configure(c)
+}
+
+// AuthenticatedClient returns an untappd.Client which is configured to make
+// requests to the Server, authenticated as the user with the specified
+// username.
+func (s *Server) AuthenticatedClient(username string) *untappd.Client {
+	c, err := untappd.NewAuthenticatedClient(s.Token(username), s.HTTPClient())
+	if err != nil {
+		panic("untappdtest: failed to create client: " + err.Error())
+	}
+
+	return s.configure(c)
+}
+
+// configure points c at the Server.
+func (s *Server) configure(c *untappd.Client) *untappd.Client {
+	if err := c.SetBaseURL(s.URL); err != nil {
+		panic("untappdtest: failed to set base URL: " + err.Error())
+	}
+
+	return c
+}
+
+// Token returns a new access token, which authenticates requests to the
+// Server as the user with the specified username.
+func (s *Server) Token(username string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.newToken(username)
+}
+
+// newToken generates a new access token for username.  s.mu must be held.
+func (s *Server) newToken(username string) string {
+	token := "untappdtest-token-" + strconv.Itoa(len(s.tokens)+1)
+	s.tokens[token] = username
+
+	return token
+}
+
+// SetRateLimit sets the number of API requests allowed by the Server, and
+// resets the number of requests remaining.  Once no requests remain, the
+// Server responds to API requests with HTTP 429.  If n is zero or less, no
+// rate limit is applied, and no rate limit headers are sent.
+func (s *Server) SetRateLimit(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.limit = n
+	s.remaining = n
+}
+
+// SetError causes all further requests to endpoint, such as "beer/info/1",
+// or any endpoint beneath it, such as "beer/info", to fail with err.  If
+// err.Code is zero, HTTP 500 is used.  If err is nil, any error previously
+// set for endpoint is cleared.
+func (s *Server) SetError(endpoint string, err *untappd.Error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	endpoint = strings.Trim(endpoint, "/")
+	if err == nil {
+		delete(s.errors, endpoint)
+		return
+	}
+
+	e := *err
+	if e.Code == 0 {
+		e.Code = http.StatusInternalServerError
+	}
+	s.errors[endpoint] = &e
+}
+
+// serveHTTP serves requests for both the Untappd APIv4 and OAuth endpoints.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, errInvalidParam(err.Error()))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch strings.Trim(r.URL.Path, "/") {
+	case "oauth/authenticate":
+		s.authenticate(w, r)
+		return
+	case "oauth/authorize":
+		s.authorize(w, r)
+		return
+	}
+
+	if !strings.HasPrefix(r.URL.Path, "/v4/") {
+		writeError(w, errInvalidEndpoint)
+		return
+	}
+	endpoint := strings.Trim(strings.TrimPrefix(r.URL.Path, "/v4/"), "/")
+
+	// Every API request counts against the rate limit, and requests are
+	// rejected once none remain
+	limited := s.limit > 0 && s.remaining == 0
+	if s.limit > 0 {
+		if s.remaining > 0 {
+			s.remaining--
+		}
+
+		w.Header().Set("X-Ratelimit-Limit", strconv.Itoa(s.limit))
+		w.Header().Set("X-Ratelimit-Remaining", strconv.Itoa(s.remaining))
+	}
+
+	username, err := s.authenticateRequest(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if limited {
+		writeError(w, errRateLimit)
+		return
+	}
+
+	if err := s.endpointError(endpoint); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	req := &request{
+		form:     r.Form,
+		username: username,
+	}
+
+	v, err := s.route(r.Method, endpoint, req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeResponse(w, v)
+}
+
+// authenticateRequest verifies the credentials of an API request, returning
+// the authenticated username, if any.
+func (s *Server) authenticateRequest(r *http.Request) (string, *untappd.Error) {
+	if token := r.Form.Get("access_token"); token != "" {
+		username, ok := s.tokens[token]
+		if !ok {
+			return "", errInvalidAuth
+		}
+
+		return username, nil
+	}
+
+	if r.Form.Get("client_id") != ClientID || r.Form.Get("client_secret") != ClientSecret {
+		return "", errInvalidAuth
+	}
+
+	return "", nil
+}
+
+// endpointError returns any error set using SetError for endpoint, or any of
+// its parent endpoints.
+func (s *Server) endpointError(endpoint string) *untappd.Error {
+	for e := endpoint; e != ""; {
+		if err, ok := s.errors[e]; ok {
+			return err
+		}
+
+		i := strings.LastIndex(e, "/")
+		if i == -1 {
+			break
+		}
+		e = e[:i]
+	}
+
+	return nil
+}
+
+// authenticate implements the OAuth authenticate endpoint.  The request is
+// immediately approved by the Server's AuthUser, and the user agent is
+// redirected to the redirect URL with an authorization code.
+func (s *Server) authenticate(w http.ResponseWriter, r *http.Request) {
+	if r.Form.Get("client_id") != ClientID {
+		writeError(w, errInvalidAuth)
+		return
+	}
+
+	ru, err := url.Parse(r.Form.Get("redirect_url"))
+	if err != nil || ru.String() == "" {
+		writeError(w, errInvalidParam("The redirect_url field is required."))
+		return
+	}
+
+	username := s.seed.AuthUser
+	if username == "" && len(s.seed.Users) > 0 {
+		username = s.seed.Users[0].UserName
+	}
+
+	code := "untappdtest-code-" + strconv.Itoa(len(s.codes)+1)
+	s.codes[code] = username
+
+	// Pass any state parameter through to the redirect URL
+	q := ru.Query()
+	q.Set("code", code)
+	if state := r.Form.Get("state"); state != "" {
+		q.Set("state", state)
+	}
+	ru.RawQuery = q.Encode()
+
+	http.Redirect(w, r, ru.String(), http.StatusFound)
+}
+
+// authorize implements the OAuth authorize endpoint, exchanging an
+// authorization code for an access token.  Each code may only be used once.
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request) {
+	if r.Form.Get("client_id") != ClientID || r.Form.Get("client_secret") != ClientSecret {
+		writeError(w, errInvalidAuth)
+		return
+	}
+
+	username, ok := s.codes[r.Form.Get("code")]
+	if !ok {
+		writeError(w, errInvalidParam("The code is invalid or has expired."))
+		return
+	}
+	delete(s.codes, r.Form.Get("code"))
+
+	writeResponse(w, object{
+		"access_token": s.newToken(username),
+	})
+}
+
+// writeResponse writes a successful API response containing v.
+func writeResponse(w http.ResponseWriter, v interface{}) {
+	writeJSON(w, http.StatusOK, object{
+		"meta": object{
+			"code":          http.StatusOK,
+			"response_time": responseTime,
+		},
+		"notifications": object{},
+		"response":      v,
+	})
+}
+
+// writeError writes an API error response for err.
+func writeError(w http.ResponseWriter, err *untappd.Error) {
+	writeJSON(w, err.Code, object{
+		"meta": object{
+			"code":               err.Code,
+			"error_detail":       err.Detail,
+			"error_type":         err.Type,
+			"developer_friendly": err.DeveloperFriendly,
+			"response_time":      responseTime,
+		},
+		"response": []interface{}{},
+	})
+}
+
+// writeJSON writes v as JSON with the specified HTTP status code.
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// responseTime is the response time reported by every response.
+var responseTime = object{
+	"time":    0,
+	"measure": "seconds",
+}
+
+// transport is a http.RoundTripper which sends requests for the Untappd
+// APIv4 and OAuth hosts to a Server.
+type transport struct {
+	host string
+	rt   http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	switch r.URL.Host {
+	case "untappd.com", "api.untappd.com":
+		r = r.Clone(r.Context())
+		r.URL.Scheme = "http"
+		r.URL.Host = t.host
+		r.Host = t.host
+	}
+
+	return t.rt.RoundTrip(r)
+}
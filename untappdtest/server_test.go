@@ -0,0 +1,409 @@
+package untappdtest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/mdlayher/untappd"
+)
+
+// TestServerBeerInfoOK verifies that a Server serves a seeded beer, along
+// with its seeded brewery.
+func TestServerBeerInfoOK(t *testing.T) {
+	s := NewServer(testSeed())
+	defer s.Close()
+
+	beer, _, err := s.Client().Beer.Info(1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if beer.Name != "Two Hearted Ale" {
+		t.Fatalf("unexpected beer Name: %q != %q", beer.Name, "Two Hearted Ale")
+	}
+	if !beer.InProduction {
+		t.Fatal("expected beer to be in production")
+	}
+	if beer.Brewery == nil || beer.Brewery.Name != "Bell's Brewery, Inc." {
+		t.Fatalf("unexpected beer Brewery: %v", beer.Brewery)
+	}
+}
+
+// TestServerBeerInfoBadBeer verifies that a Server returns the same error as
+// the Untappd APIv4 for an unknown beer.
+func TestServerBeerInfoBadBeer(t *testing.T) {
+	s := NewServer(testSeed())
+	defer s.Close()
+
+	_, _, err := s.Client().Beer.Info(-1, false)
+	assertError(t, err, "invalid_param", "This Beer ID is invalid.")
+}
+
+// TestServerInvalidCredentials verifies that a Server rejects requests with
+// unknown credentials.
+func TestServerInvalidCredentials(t *testing.T) {
+	s := NewServer(testSeed())
+	defer s.Close()
+
+	c, err := untappd.NewClient("foo", "bar", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetBaseURL(s.URL); err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = c.Beer.Info(1, false)
+	assertError(t, err, "invalid_auth", "The user has not authorized this application or the token is invalid.")
+}
+
+// TestServerUserAllCheckinsOK verifies that a Server pages through a user's
+// checkins using maximum checkin IDs.
+func TestServerUserAllCheckinsOK(t *testing.T) {
+	s := NewServer(testSeed())
+	defer s.Close()
+
+	var ids []int
+	for c, err := range s.Client().User.AllCheckins(context.Background(), "mdlayher") {
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ids = append(ids, c.ID)
+	}
+
+	// Checkins 1-120 alternate between users, so mdlayher has the even IDs
+	if l := len(ids); l != 60 {
+		t.Fatalf("unexpected number of checkins: %d != %d", l, 60)
+	}
+	for i, id := range ids {
+		if want := 120 - 2*i; id != want {
+			t.Fatalf("unexpected checkin ID at index %d: %d != %d", i, id, want)
+		}
+	}
+}
+
+// TestServerBreweryBeersOffsetLimitSortOK verifies that a Server sorts and
+// pages through a brewery's beers.
+func TestServerBreweryBeersOffsetLimitSortOK(t *testing.T) {
+	s := NewServer(testSeed())
+	defer s.Close()
+
+	beers, _, err := s.Client().Brewery.BeersOffsetLimitSort(1, 1, 1, untappd.SortHighestABV)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if l := len(beers); l != 1 {
+		t.Fatalf("unexpected number of beers: %d != %d", l, 1)
+	}
+	if id := beers[0].ID; id != 1 {
+		t.Fatalf("unexpected beer ID: %d != %d", id, 1)
+	}
+}
+
+// TestServerUserBeersOK verifies that a Server derives a user's beers from
+// their checkins.
+func TestServerUserBeersOK(t *testing.T) {
+	s := NewServer(testSeed())
+	defer s.Close()
+
+	beers, _, err := s.Client().User.Beers("mdlayher")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if l := len(beers); l != 2 {
+		t.Fatalf("unexpected number of beers: %d != %d", l, 2)
+	}
+	for _, b := range beers {
+		if b.Count != 30 {
+			t.Fatalf("unexpected beer Count for %d: %d != %d", b.ID, b.Count, 30)
+		}
+		if b.FirstHad.After(b.RecentHad) {
+			t.Fatalf("unexpected beer FirstHad after RecentHad: %v > %v", b.FirstHad, b.RecentHad)
+		}
+	}
+}
+
+// TestServerLocalCheckinsOK verifies that a Server only returns checkins
+// within the requested radius.
+func TestServerLocalCheckinsOK(t *testing.T) {
+	s := NewServer(testSeed())
+	defer s.Close()
+
+	checkins, _, err := s.Client().Local.CheckinsMinMaxIDLimitRadius(untappd.LocalCheckinsRequest{
+		Latitude:  42.29,
+		Longitude: -85.58,
+		Limit:     50,
+		Radius:    5,
+		Units:     untappd.DistanceMiles,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if l := len(checkins); l != 50 {
+		t.Fatalf("unexpected number of checkins: %d != %d", l, 50)
+	}
+
+	checkins, _, err = s.Client().Local.Checkins(0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l := len(checkins); l != 0 {
+		t.Fatalf("unexpected number of checkins: %d != %d", l, 0)
+	}
+}
+
+// TestServerCheckinOK verifies that a Server adds checkins made by an
+// authenticated user, and serves them to the user's friends.
+func TestServerCheckinOK(t *testing.T) {
+	s := NewServer(testSeed())
+	defer s.Close()
+
+	checkin, _, err := s.AuthenticatedClient("mdlayher").Auth.Checkin(untappd.CheckinRequest{
+		BeerID:  2,
+		Comment: "tasty",
+		Rating:  4.5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if checkin.ID != 121 {
+		t.Fatalf("unexpected checkin ID: %d != %d", checkin.ID, 121)
+	}
+	if checkin.Beer.ID != 2 || checkin.User.UserName != "mdlayher" {
+		t.Fatalf("unexpected checkin: %v", checkin)
+	}
+
+	checkins, _, err := s.AuthenticatedClient("untappd").Auth.Checkins()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(checkins) == 0 || checkins[0].ID != 121 || checkins[0].Comment != "tasty" {
+		t.Fatalf("unexpected friend checkins: %v", checkins)
+	}
+}
+
+// TestServerCheckinUnauthenticated verifies that a Server does not allow
+// unauthenticated checkins.
+func TestServerCheckinUnauthenticated(t *testing.T) {
+	s := NewServer(testSeed())
+	defer s.Close()
+
+	_, _, err := s.Client().Auth.Checkin(untappd.CheckinRequest{BeerID: 1})
+	assertError(t, err, "invalid_auth", "The user has not authorized this application or the token is invalid.")
+}
+
+// TestServerSetRateLimit verifies that a Server reports its rate limit, and
+// rejects requests once it is exhausted.
+func TestServerSetRateLimit(t *testing.T) {
+	s := NewServer(testSeed())
+	defer s.Close()
+
+	s.SetRateLimit(2)
+	c := s.Client()
+
+	for i := 1; i >= 0; i-- {
+		_, res, err := c.Beer.Info(1, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r := res.Header.Get("X-Ratelimit-Remaining"); r != strconv.Itoa(i) {
+			t.Fatalf("unexpected rate limit remaining: %q != %q", r, strconv.Itoa(i))
+		}
+	}
+
+	_, res, err := c.Beer.Info(1, true)
+	if res.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("unexpected HTTP status: %d != %d", res.StatusCode, http.StatusTooManyRequests)
+	}
+	assertError(t, err, "invalid_limit", "You have exceeded the allowed rate limit.")
+
+	s.SetRateLimit(0)
+	_, res, err = c.Beer.Info(1, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := res.Header.Get("X-Ratelimit-Remaining"); r != "" {
+		t.Fatalf("unexpected rate limit header: %q", r)
+	}
+}
+
+// TestServerSetError verifies that a Server returns errors set for an
+// endpoint, and any endpoints beneath it, until cleared.
+func TestServerSetError(t *testing.T) {
+	s := NewServer(testSeed())
+	defer s.Close()
+
+	s.SetError("beer", &untappd.Error{
+		Type:   "server_error",
+		Detail: "Something went wrong.",
+	})
+
+	c := s.Client()
+	_, _, err := c.Beer.Info(1, false)
+	assertError(t, err, "server_error", "Something went wrong.")
+
+	if _, _, err := c.Brewery.Info(1, false); err != nil {
+		t.Fatal(err)
+	}
+
+	s.SetError("beer", nil)
+	if _, _, err := c.Beer.Info(1, false); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestServerOAuthOK verifies that a Server completes the OAuth flow used by
+// untappd.AuthHandler, issuing a token for the seeded AuthUser.
+func TestServerOAuthOK(t *testing.T) {
+	s := NewServer(testSeed())
+	defer s.Close()
+
+	var token string
+	h, err := untappd.NewOAuthHandler(ClientID, ClientSecret, "http://example.com/callback", func(tok string, w http.ResponseWriter, r *http.Request) {
+		token = tok
+	}, s.HTTPClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Begin authentication, which redirects to Untappd and saves the OAuth
+	// state in a cookie
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "http://example.com/callback", nil))
+	if w.Code != http.StatusFound {
+		t.Fatalf("unexpected HTTP status: %d != %d: %s", w.Code, http.StatusFound, w.Body.String())
+	}
+	cookies := w.Result().Cookies()
+
+	// Approve the authentication request, and capture the redirect
+	hc := s.HTTPClient()
+	hc.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	res, err := hc.Get(w.Header().Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	loc, err := url.Parse(res.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := httptest.NewRequest("GET", loc.String(), nil)
+	for _, c := range cookies {
+		r.AddCookie(c)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected HTTP status: %d != %d: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	c, err := untappd.NewAuthenticatedClient(token, s.HTTPClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetBaseURL(s.URL); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := c.Auth.Checkins(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// assertError asserts that err is an *untappd.Error with the expected type
+// and detail.
+func assertError(t *testing.T, err error, typ string, detail string) {
+	uErr, ok := err.(*untappd.Error)
+	if !ok {
+		t.Fatalf("error is not *untappd.Error: %T", err)
+	}
+
+	if uErr.Type != typ {
+		t.Fatalf("unexpected error Type: %q != %q", uErr.Type, typ)
+	}
+	if uErr.Detail != detail {
+		t.Fatalf("unexpected error Detail: %q != %q", uErr.Detail, detail)
+	}
+}
+
+// testSeed returns a Seed with two users who are friends, a brewery with
+// two beers, a venue, and 120 checkins alternating between the users and
+// beers.
+func testSeed() Seed {
+	bells := &untappd.Brewery{
+		ID:   1,
+		Name: "Bell's Brewery, Inc.",
+	}
+
+	beers := []*untappd.Beer{
+		{
+			ID:           1,
+			Name:         "Two Hearted Ale",
+			ABV:          7.0,
+			InProduction: true,
+			Brewery:      &untappd.Brewery{ID: 1},
+		},
+		{
+			ID:      2,
+			Name:    "Expedition Stout",
+			ABV:     10.5,
+			Brewery: &untappd.Brewery{ID: 1},
+		},
+	}
+
+	users := []*untappd.User{
+		{UID: 1, UserName: "mdlayher"},
+		{UID: 2, UserName: "untappd"},
+	}
+
+	venue := &untappd.Venue{
+		ID:   1,
+		Name: "Bell's Eccentric Cafe",
+		Location: untappd.VenueLocation{
+			Latitude:  42.2917,
+			Longitude: -85.5872,
+		},
+	}
+
+	var checkins []*untappd.Checkin
+	start := time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for i := 1; i <= 120; i++ {
+		checkins = append(checkins, &untappd.Checkin{
+			ID:      i,
+			Created: start.Add(time.Duration(i) * time.Hour),
+			User:    users[i%2],
+			Beer:    beers[(i/2)%2],
+			Venue:   venue,
+		})
+	}
+
+	return Seed{
+		Beers:     beers,
+		Breweries: []*untappd.Brewery{bells},
+		Venues:    []*untappd.Venue{venue},
+		Users:     users,
+		Checkins:  checkins,
+		Friends: map[string][]string{
+			"mdlayher": {"untappd"},
+			"untappd":  {"mdlayher"},
+		},
+		AuthUser: "untappd",
+	}
+}
@@ -1,7 +1,9 @@
 package untappd
 
 import (
+	"encoding/json"
 	"net/url"
+	"time"
 )
 
 // UserService is a "service" which allows access to API methods involving users.
@@ -21,6 +23,17 @@ type User struct {
 	Location  string
 	Bio       string
 	Supporter bool
+	Private   bool
+
+	// Type of account, such as "user" or "brewery".
+	AccountType string
+
+	// Relationship of this user to the authenticated user, such as "self",
+	// "friends", or "none".  Only available to authenticated clients.
+	Relationship string
+
+	// Time when this user joined Untappd.
+	DateJoined time.Time
 
 	// Links to the user's avatar, cover photo, custom URL, and Untappd profile.
 	Avatar     url.URL
@@ -31,6 +44,29 @@ type User struct {
 	// Struct containing this user's total badges, friends, checkins,
 	// and other various totals.
 	Stats UserStats
+
+	// If available, beers this user has recently checked in.
+	RecentBeers []*Beer
+
+	// If available, this user's recent checkins.
+	Checkins []*Checkin
+
+	// If available, photos from this user's recent checkins.
+	Media []*Photo
+
+	// If available, badges this user has recently earned.
+	Badges []*Badge
+
+	// The number of recent beers, checkins, photos, and badges reported
+	// by the API, which may differ from the length of each list.
+	RecentBeerCount int
+	CheckinCount    int
+	MediaCount      int
+	BadgeCount      int
+
+	// If Client.RawJSON is enabled, the original JSON representation of
+	// this user, including any fields which are not otherwise exported.
+	Raw json.RawMessage `json:",omitempty"`
 }
 
 // UserStats is a struct which contains various statistics regarding an Untappd
@@ -48,39 +84,72 @@ type UserStats struct {
 // rawUser is the raw JSON representation of an Untappd user.  Its data is
 // unmarshaled from JSON and then exported to a User struct.
 type rawUser struct {
-	UID        int          `json:"uid"`
-	ID         int          `json:"id"`
-	UserName   string       `json:"user_name"`
-	FirstName  string       `json:"first_name"`
-	LastName   string       `json:"last_name"`
-	Avatar     responseURL  `json:"user_avatar"`
-	AvatarHD   responseURL  `json:"user_avatar_hd"`
-	CoverPhoto responseURL  `json:"user_cover_photo"`
-	Location   string       `json:"location"`
-	URL        responseURL  `json:"url"`
-	Bio        string       `json:"bio"`
-	Supporter  responseBool `json:"is_supporter"`
-	UntappdURL responseURL  `json:"untappd_url"`
-	Stats      UserStats    `json:"stats"`
+	UID          int          `json:"uid"`
+	ID           int          `json:"id"`
+	UserName     string       `json:"user_name"`
+	FirstName    string       `json:"first_name"`
+	LastName     string       `json:"last_name"`
+	Avatar       responseURL  `json:"user_avatar"`
+	AvatarHD     responseURL  `json:"user_avatar_hd"`
+	CoverPhoto   responseURL  `json:"user_cover_photo"`
+	Location     string       `json:"location"`
+	URL          responseURL  `json:"url"`
+	Bio          string       `json:"bio"`
+	Supporter    responseBool `json:"is_supporter"`
+	Private      responseBool `json:"is_private"`
+	AccountType  string       `json:"account_type"`
+	Relationship string       `json:"relationship"`
+	DateJoined   responseTime `json:"date_joined"`
+	UntappdURL   responseURL  `json:"untappd_url"`
+	Stats        UserStats    `json:"stats"`
+
+	RecentBrews struct {
+		Count int `json:"count"`
+		Items []struct {
+			Beer    rawBeer    `json:"beer"`
+			Brewery rawBrewery `json:"brewery"`
+		} `json:"items"`
+	} `json:"recent_brews"`
+
+	Checkins struct {
+		Count int           `json:"count"`
+		Items []*rawCheckin `json:"items"`
+	} `json:"checkins"`
+
+	Media struct {
+		Count int         `json:"count"`
+		Items []*rawPhoto `json:"items"`
+	} `json:"media"`
+
+	Badges struct {
+		Count int         `json:"count"`
+		Items []*rawBadge `json:"items"`
+	} `json:"badges"`
+
+	Raw json.RawMessage `json:"-"`
 }
 
 // export creates an exported User from a rawUser struct, allowing for more
 // useful structures to be created for client consumption.
 func (r *rawUser) export() *User {
 	u := &User{
-		UID:        r.UID,
-		ID:         r.ID,
-		UserName:   r.UserName,
-		FirstName:  r.FirstName,
-		LastName:   r.LastName,
-		Avatar:     url.URL(r.Avatar),
-		CoverPhoto: url.URL(r.CoverPhoto),
-		Location:   r.Location,
-		URL:        url.URL(r.URL),
-		Bio:        r.Bio,
-		Supporter:  bool(r.Supporter),
-		UntappdURL: url.URL(r.UntappdURL),
-		Stats:      r.Stats,
+		UID:          r.UID,
+		ID:           r.ID,
+		UserName:     r.UserName,
+		FirstName:    r.FirstName,
+		LastName:     r.LastName,
+		Avatar:       url.URL(r.Avatar),
+		CoverPhoto:   url.URL(r.CoverPhoto),
+		Location:     r.Location,
+		URL:          url.URL(r.URL),
+		Bio:          r.Bio,
+		Supporter:    bool(r.Supporter),
+		Private:      bool(r.Private),
+		AccountType:  r.AccountType,
+		Relationship: r.Relationship,
+		DateJoined:   time.Time(r.DateJoined),
+		UntappdURL:   url.URL(r.UntappdURL),
+		Stats:        r.Stats,
 	}
 
 	// If high resolution avatar is available, use it instead
@@ -88,5 +157,50 @@ func (r *rawUser) export() *User {
 		u.Avatar = a
 	}
 
+	beers := make([]*Beer, 0, len(r.RecentBrews.Items))
+	for _, item := range r.RecentBrews.Items {
+		beer := item.Beer.export()
+		beer.Brewery = item.Brewery.export()
+
+		beers = append(beers, beer)
+	}
+	u.RecentBeers = beers
+	u.RecentBeerCount = r.RecentBrews.Count
+
+	checkins := make([]*Checkin, 0, len(r.Checkins.Items))
+	for _, item := range r.Checkins.Items {
+		if item == nil {
+			continue
+		}
+
+		checkins = append(checkins, item.export())
+	}
+	u.Checkins = checkins
+	u.CheckinCount = r.Checkins.Count
+
+	media := make([]*Photo, 0, len(r.Media.Items))
+	for _, item := range r.Media.Items {
+		if item == nil {
+			continue
+		}
+
+		media = append(media, item.export())
+	}
+	u.Media = media
+	u.MediaCount = r.Media.Count
+
+	badges := make([]*Badge, 0, len(r.Badges.Items))
+	for _, item := range r.Badges.Items {
+		if item == nil {
+			continue
+		}
+
+		badges = append(badges, item.export())
+	}
+	u.Badges = badges
+	u.BadgeCount = r.Badges.Count
+
+	u.Raw = r.Raw
+
 	return u
 }
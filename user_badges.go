@@ -1,6 +1,9 @@
 package untappd
 
 import (
+	"context"
+	"encoding/json"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -13,8 +16,13 @@ import (
 // For more granular control, and to page through the badges list, use
 // BadgesOffsetLimit instead.
 func (u *UserService) Badges(username string) ([]*Badge, *http.Response, error) {
+	return u.BadgesContext(context.Background(), username)
+}
+
+// BadgesContext is like Badges, but uses ctx for the request.
+func (u *UserService) BadgesContext(ctx context.Context, username string) ([]*Badge, *http.Response, error) {
 	// Use default parameters as specified by API
-	return u.BadgesOffsetLimit(username, 0, 50)
+	return u.BadgesOffsetLimitContext(ctx, username, 0, 50)
 }
 
 // BadgesOffsetLimit queries for information about a User's badges, but also
@@ -24,6 +32,19 @@ func (u *UserService) Badges(username string) ([]*Badge, *http.Response, error)
 //
 // 50 badges is the maximum number of badges which may be returned by one call.
 func (u *UserService) BadgesOffsetLimit(username string, offset int, limit int) ([]*Badge, *http.Response, error) {
+	return u.BadgesOffsetLimitContext(context.Background(), username, offset, limit)
+}
+
+// BadgesOffsetLimitContext is like BadgesOffsetLimit, but uses ctx for the
+// request.
+func (u *UserService) BadgesOffsetLimitContext(ctx context.Context, username string, offset int, limit int) ([]*Badge, *http.Response, error) {
+	return traceCall(ctx, u.client, "untappd.User.Badges", func(ctx context.Context) ([]*Badge, *http.Response, error) {
+		return u.badgesOffsetLimit(ctx, username, offset, limit)
+	})
+}
+
+// badgesOffsetLimit implements BadgesOffsetLimitContext.
+func (u *UserService) badgesOffsetLimit(ctx context.Context, username string, offset int, limit int) ([]*Badge, *http.Response, error) {
 	q := url.Values{
 		"offset": []string{strconv.Itoa(offset)},
 		"limit":  []string{strconv.Itoa(limit)},
@@ -32,22 +53,43 @@ func (u *UserService) BadgesOffsetLimit(username string, offset int, limit int)
 	// Temporary struct to unmarshal badges JSON
 	var v struct {
 		Response struct {
-			Count int         `json:"count"`
-			Items []*rawBadge `json:"items"`
+			Count int               `json:"count"`
+			Items []json.RawMessage `json:"items"`
 		} `json:"response"`
 	}
 
 	// Perform request for user badges by username
-	res, err := u.client.request("GET", "user/badges/"+username, nil, q, &v)
+	endpoint := "user/badges/" + username
+	res, err := u.client.requestContext(ctx, "GET", endpoint, nil, q, &v)
 	if err != nil {
 		return nil, res, err
 	}
 
 	// Build result slice from struct
-	badges := make([]*Badge, v.Response.Count)
-	for i := range v.Response.Items {
-		badges[i] = v.Response.Items[i].export()
-	}
+	var badges []*Badge
+	err = u.client.decodeItems(endpoint, "response.items", v.Response.Count, v.Response.Items, func(decode func(v interface{}) error) error {
+		var item rawBadge
+		if err := decode(&item); err != nil {
+			return err
+		}
+
+		badges = append(badges, item.export())
+		return nil
+	})
 
-	return badges, res, nil
+	return badges, res, err
+}
+
+// AllBadges returns an iterator over all of a User's badges, paging through
+// the badges list using BadgesOffsetLimit.  The username parameter specifies
+// the User whose badges will be returned.
+//
+// One API call is made for every 50 badges, and no further calls are made
+// once iteration stops.  Iteration ends after yielding a request error, or if
+// ctx is canceled.
+func (u *UserService) AllBadges(ctx context.Context, username string) iter.Seq2[*Badge, error] {
+	return offsetPages(ctx, u.client, "untappd.User.AllBadges", 50, func(ctx context.Context, offset int, limit int) ([]*Badge, error) {
+		badges, _, err := u.BadgesOffsetLimitContext(ctx, username, offset, limit)
+		return badges, err
+	})
 }
@@ -1,6 +1,9 @@
 package untappd
 
 import (
+	"context"
+	"encoding/json"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -14,8 +17,13 @@ import (
 // For more granular control, and to page through and sort the beers list, use
 // BeersOffsetLimitSort instead.
 func (u *UserService) Beers(username string) ([]*Beer, *http.Response, error) {
+	return u.BeersContext(context.Background(), username)
+}
+
+// BeersContext is like Beers, but uses ctx for the request.
+func (u *UserService) BeersContext(ctx context.Context, username string) ([]*Beer, *http.Response, error) {
 	// Use default parameters as specified by API
-	return u.BeersOffsetLimitSort(username, 0, 25, SortDate)
+	return u.BeersOffsetLimitSortContext(ctx, username, 0, 25, SortDate)
 }
 
 // BeersOffsetLimitSort queries for information about a User's checked-in beers,
@@ -26,6 +34,19 @@ func (u *UserService) Beers(username string) ([]*Beer, *http.Response, error) {
 //
 // 50 beers is the maximum number of beers which may be returned by one call.
 func (u *UserService) BeersOffsetLimitSort(username string, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error) {
+	return u.BeersOffsetLimitSortContext(context.Background(), username, offset, limit, sort)
+}
+
+// BeersOffsetLimitSortContext is like BeersOffsetLimitSort, but uses ctx for
+// the request.
+func (u *UserService) BeersOffsetLimitSortContext(ctx context.Context, username string, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error) {
+	return traceCall(ctx, u.client, "untappd.User.Beers", func(ctx context.Context) ([]*Beer, *http.Response, error) {
+		return u.beersOffsetLimitSort(ctx, username, offset, limit, sort)
+	})
+}
+
+// beersOffsetLimitSort implements BeersOffsetLimitSortContext.
+func (u *UserService) beersOffsetLimitSort(ctx context.Context, username string, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error) {
 	q := url.Values{
 		"offset": []string{strconv.Itoa(offset)},
 		"limit":  []string{strconv.Itoa(limit)},
@@ -36,41 +57,65 @@ func (u *UserService) BeersOffsetLimitSort(username string, offset int, limit in
 	var v struct {
 		Response struct {
 			Beers struct {
-				Count int `json:"count"`
-				Items []struct {
-					FirstCheckin  responseTime `json:"first_created_at"`
-					RecentCheckin responseTime `json:"recent_created_at"`
-					UserRating    float64      `json:"rating_score"`
-					Count         int          `json:"count"`
-
-					Beer    rawBeer    `json:"beer"`
-					Brewery rawBrewery `json:"brewery"`
-				} `json:"items"`
+				Count int               `json:"count"`
+				Items []json.RawMessage `json:"items"`
 			} `json:"beers"`
 		} `json:"response"`
 	}
 
 	// Perform request for user beers by username
-	res, err := u.client.request("GET", "user/beers/"+username, nil, q, &v)
+	endpoint := "user/beers/" + username
+	res, err := u.client.requestContext(ctx, "GET", endpoint, nil, q, &v)
 	if err != nil {
 		return nil, res, err
 	}
 
 	// Build result slice from struct
-	beers := make([]*Beer, v.Response.Beers.Count)
-	for i := range v.Response.Beers.Items {
+	var beers []*Beer
+	err = u.client.decodeItems(endpoint, "response.beers.items", v.Response.Beers.Count, v.Response.Beers.Items, func(decode func(v interface{}) error) error {
+		var item struct {
+			FirstCheckin  responseTime `json:"first_created_at"`
+			RecentCheckin responseTime `json:"recent_created_at"`
+			UserRating    float64      `json:"rating_score"`
+			Count         int          `json:"count"`
+
+			Beer    rawBeer    `json:"beer"`
+			Brewery rawBrewery `json:"brewery"`
+		}
+		if err := decode(&item); err != nil {
+			return err
+		}
+
 		// Information about the beer itself
-		beers[i] = v.Response.Beers.Items[i].Beer.export()
+		beer := item.Beer.export()
 
 		// Information about the beer's brewery
-		beers[i].Brewery = v.Response.Beers.Items[i].Brewery.export()
+		beer.Brewery = item.Brewery.export()
 
 		// Information related to this user and this beer
-		beers[i].FirstHad = time.Time(v.Response.Beers.Items[i].FirstCheckin)
-		beers[i].RecentHad = time.Time(v.Response.Beers.Items[i].RecentCheckin)
-		beers[i].UserRating = v.Response.Beers.Items[i].UserRating
-		beers[i].Count = v.Response.Beers.Items[i].Count
-	}
+		beer.FirstHad = time.Time(item.FirstCheckin)
+		beer.RecentHad = time.Time(item.RecentCheckin)
+		beer.UserRating = item.UserRating
+		beer.Count = item.Count
+
+		beers = append(beers, beer)
+		return nil
+	})
 
-	return beers, res, nil
+	return beers, res, err
+}
+
+// AllBeers returns an iterator over all beers a User has checked in, paging
+// through the beers list using BeersOffsetLimitSort.  The username parameter
+// specifies the User whose beers will be returned, and beers may be sorted
+// using any of the provided Sort constants with this package.
+//
+// One API call is made for every 50 beers, and no further calls are made
+// once iteration stops.  Iteration ends after yielding a request error, or if
+// ctx is canceled.
+func (u *UserService) AllBeers(ctx context.Context, username string, sort Sort) iter.Seq2[*Beer, error] {
+	return offsetPages(ctx, u.client, "untappd.User.AllBeers", 50, func(ctx context.Context, offset int, limit int) ([]*Beer, error) {
+		beers, _, err := u.BeersOffsetLimitSortContext(ctx, username, offset, limit, sort)
+		return beers, err
+	})
 }
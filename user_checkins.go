@@ -1,6 +1,8 @@
 package untappd
 
 import (
+	"context"
+	"iter"
 	"math"
 	"net/http"
 	"net/url"
@@ -15,9 +17,14 @@ import (
 // For more granular control, and to page through the checkins list using ID
 // parameters, use CheckinsMinMaxIDLimit instead.
 func (u *UserService) Checkins(username string) ([]*Checkin, *http.Response, error) {
+	return u.CheckinsContext(context.Background(), username)
+}
+
+// CheckinsContext is like Checkins, but uses ctx for the request.
+func (u *UserService) CheckinsContext(ctx context.Context, username string) ([]*Checkin, *http.Response, error) {
 	// Use default parameters as specified by API.  Max ID is somewhat
 	// arbitrary, but should provide plenty of headroom, just in case.
-	return u.CheckinsMinMaxIDLimit(username, 0, math.MaxInt32, 25)
+	return u.CheckinsMinMaxIDLimitContext(ctx, username, 0, math.MaxInt32, 25)
 }
 
 // CheckinsMinMaxIDLimit queries for information about a User's checkins,
@@ -28,6 +35,19 @@ func (u *UserService) Checkins(username string) ([]*Checkin, *http.Response, err
 // 50 checkins is the maximum number of checkins which may be returned by
 // one call.
 func (u *UserService) CheckinsMinMaxIDLimit(username string, minID int, maxID int, limit int) ([]*Checkin, *http.Response, error) {
+	return u.CheckinsMinMaxIDLimitContext(context.Background(), username, minID, maxID, limit)
+}
+
+// CheckinsMinMaxIDLimitContext is like CheckinsMinMaxIDLimit, but uses ctx for
+// the request.
+func (u *UserService) CheckinsMinMaxIDLimitContext(ctx context.Context, username string, minID int, maxID int, limit int) ([]*Checkin, *http.Response, error) {
+	return traceCall(ctx, u.client, "untappd.User.Checkins", func(ctx context.Context) ([]*Checkin, *http.Response, error) {
+		return u.checkinsMinMaxIDLimit(ctx, username, minID, maxID, limit)
+	})
+}
+
+// checkinsMinMaxIDLimit implements CheckinsMinMaxIDLimitContext.
+func (u *UserService) checkinsMinMaxIDLimit(ctx context.Context, username string, minID int, maxID int, limit int) ([]*Checkin, *http.Response, error) {
 	v := url.Values{}
 	if minID != 0 {
 		v.Set("min_id", strconv.Itoa(minID))
@@ -36,5 +56,19 @@ func (u *UserService) CheckinsMinMaxIDLimit(username string, minID int, maxID in
 		v.Set("max_id", strconv.Itoa(maxID))
 	}
 	v.Set("limit", strconv.Itoa(limit))
-	return u.client.getCheckins("user/checkins/"+username, v)
+	return u.client.getCheckins(ctx, "user/checkins/"+username, v)
+}
+
+// AllCheckins returns an iterator over all of a User's checkins, newest
+// first, paging through the checkins list using CheckinsMinMaxIDLimit.
+// The username parameter specifies the User whose checkins will be returned.
+//
+// One API call is made for every 50 checkins, and no further calls are made
+// once iteration stops.  Iteration ends after yielding a request error, or if
+// ctx is canceled.
+func (u *UserService) AllCheckins(ctx context.Context, username string) iter.Seq2[*Checkin, error] {
+	return checkinPages(ctx, u.client, "untappd.User.AllCheckins", math.MaxInt32, 50, func(ctx context.Context, maxID int, limit int) ([]*Checkin, error) {
+		checkins, _, err := u.CheckinsMinMaxIDLimitContext(ctx, username, 0, maxID, limit)
+		return checkins, err
+	})
 }
@@ -1,6 +1,9 @@
 package untappd
 
 import (
+	"context"
+	"encoding/json"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -17,8 +20,13 @@ import (
 // information than a call to Info would.  However, basic information such as
 // user ID, username, first name, last name, bio, etc. is available.
 func (u *UserService) Friends(username string) ([]*User, *http.Response, error) {
+	return u.FriendsContext(context.Background(), username)
+}
+
+// FriendsContext is like Friends, but uses ctx for the request.
+func (u *UserService) FriendsContext(ctx context.Context, username string) ([]*User, *http.Response, error) {
 	// Use default parameters as specified by API
-	return u.FriendsOffsetLimit(username, 0, 25)
+	return u.FriendsOffsetLimitContext(ctx, username, 0, 25)
 }
 
 // FriendsOffsetLimit queries for information about a User's friends, but also
@@ -28,6 +36,19 @@ func (u *UserService) Friends(username string) ([]*User, *http.Response, error)
 //
 // 25 friends is the maximum number of friends which may be returned by one call.
 func (u *UserService) FriendsOffsetLimit(username string, offset int, limit int) ([]*User, *http.Response, error) {
+	return u.FriendsOffsetLimitContext(context.Background(), username, offset, limit)
+}
+
+// FriendsOffsetLimitContext is like FriendsOffsetLimit, but uses ctx for the
+// request.
+func (u *UserService) FriendsOffsetLimitContext(ctx context.Context, username string, offset int, limit int) ([]*User, *http.Response, error) {
+	return traceCall(ctx, u.client, "untappd.User.Friends", func(ctx context.Context) ([]*User, *http.Response, error) {
+		return u.friendsOffsetLimit(ctx, username, offset, limit)
+	})
+}
+
+// friendsOffsetLimit implements FriendsOffsetLimitContext.
+func (u *UserService) friendsOffsetLimit(ctx context.Context, username string, offset int, limit int) ([]*User, *http.Response, error) {
 	q := url.Values{
 		"offset": []string{strconv.Itoa(offset)},
 		"limit":  []string{strconv.Itoa(limit)},
@@ -36,24 +57,45 @@ func (u *UserService) FriendsOffsetLimit(username string, offset int, limit int)
 	// Temporary struct to unmarshal friends JSON
 	var v struct {
 		Response struct {
-			Count int `json:"count"`
-			Items []struct {
-				User rawUser `json:"user"`
-			} `json:"items"`
+			Count int               `json:"count"`
+			Items []json.RawMessage `json:"items"`
 		} `json:"response"`
 	}
 
 	// Perform request for user friends by username
-	res, err := u.client.request("GET", "user/friends/"+username, nil, q, &v)
+	endpoint := "user/friends/" + username
+	res, err := u.client.requestContext(ctx, "GET", endpoint, nil, q, &v)
 	if err != nil {
 		return nil, res, err
 	}
 
 	// Build result slice from struct
-	users := make([]*User, v.Response.Count)
-	for i := range v.Response.Items {
-		users[i] = v.Response.Items[i].User.export()
-	}
+	var users []*User
+	err = u.client.decodeItems(endpoint, "response.items", v.Response.Count, v.Response.Items, func(decode func(v interface{}) error) error {
+		var item struct {
+			User rawUser `json:"user"`
+		}
+		if err := decode(&item); err != nil {
+			return err
+		}
+
+		users = append(users, item.User.export())
+		return nil
+	})
 
-	return users, res, nil
+	return users, res, err
+}
+
+// AllFriends returns an iterator over all of a User's friends, paging through
+// the friends list using FriendsOffsetLimit.  The username parameter specifies
+// the User whose friends will be returned.
+//
+// One API call is made for every 25 friends, and no further calls are made
+// once iteration stops.  Iteration ends after yielding a request error, or if
+// ctx is canceled.
+func (u *UserService) AllFriends(ctx context.Context, username string) iter.Seq2[*User, error] {
+	return offsetPages(ctx, u.client, "untappd.User.AllFriends", 25, func(ctx context.Context, offset int, limit int) ([]*User, error) {
+		users, _, err := u.FriendsOffsetLimitContext(ctx, username, offset, limit)
+		return users, err
+	})
 }
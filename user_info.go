@@ -1,6 +1,7 @@
 package untappd
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 )
@@ -9,6 +10,18 @@ import (
 // If the compact parameter is set to 'true', only basic user information will
 // be populated.
 func (u *UserService) Info(username string, compact bool) (*User, *http.Response, error) {
+	return u.InfoContext(context.Background(), username, compact)
+}
+
+// InfoContext is like Info, but uses ctx for the request.
+func (u *UserService) InfoContext(ctx context.Context, username string, compact bool) (*User, *http.Response, error) {
+	return traceCall(ctx, u.client, "untappd.User.Info", func(ctx context.Context) (*User, *http.Response, error) {
+		return u.info(ctx, username, compact)
+	})
+}
+
+// info implements InfoContext.
+func (u *UserService) info(ctx context.Context, username string, compact bool) (*User, *http.Response, error) {
 	// Determine if a compact response is requested
 	q := url.Values{}
 	if compact {
@@ -23,10 +36,25 @@ func (u *UserService) Info(username string, compact bool) (*User, *http.Response
 	}
 
 	// Perform request for user information by username
-	res, err := u.client.request("GET", "user/info/"+username, nil, q, &v)
+	res, err := u.client.requestContext(ctx, "GET", "user/info/"+username, nil, q, &v)
 	if err != nil {
 		return nil, res, err
 	}
 
 	return v.Response.User.export(), res, nil
 }
+
+// InfoMany queries for information about each User with the specified usernames,
+// using up to Client.BatchConcurrency concurrent requests.  The returned
+// slice contains a User for each of usernames, in the same order.
+//
+// If information about any User could not be retrieved, InfoMany returns
+// ItemErrors, and the User at the index of each failure is nil.  Once ctx
+// is canceled or the rate limit is exhausted, ErrRateLimitExhausted or
+// the context's error is returned for each remaining User.
+func (u *UserService) InfoMany(ctx context.Context, usernames []string, compact bool) ([]*User, error) {
+	return batch(ctx, u.client, "untappd.User.InfoMany", usernames, func(ctx context.Context, username string) (*User, error) {
+		user, _, err := u.InfoContext(ctx, username, compact)
+		return user, err
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 )
 
 // TestClientUserInfoBadUser verifies that Client.User.Info returns an error when
@@ -74,6 +75,64 @@ func TestClientUserInfoOK(t *testing.T) {
 	if u := u.UserName; u != username {
 		t.Fatalf("unexpected username: %q != %q", u, username)
 	}
+	accountType := "user"
+	if a := u.AccountType; a != accountType {
+		t.Fatalf("unexpected AccountType: %q != %q", a, accountType)
+	}
+	relationship := "self"
+	if r := u.Relationship; r != relationship {
+		t.Fatalf("unexpected Relationship: %q != %q", r, relationship)
+	}
+	if u.Private {
+		t.Fatal("expected Private to be false")
+	}
+	dateJoined := time.Date(2010, 7, 7, 5, 51, 10, 0, time.UTC)
+	if d := u.DateJoined; !d.Equal(dateJoined) {
+		t.Fatalf("unexpected DateJoined: %v != %v", d, dateJoined)
+	}
+
+	if l := len(u.RecentBeers); l != 1 {
+		t.Fatalf("unexpected RecentBeers length: %d != %d", l, 1)
+	}
+	beerName := "Brooklyn Bowl Pale Ale"
+	if n := u.RecentBeers[0].Name; n != beerName {
+		t.Fatalf("unexpected RecentBeers[0].Name: %q != %q", n, beerName)
+	}
+	breweryName := "Kelso of Brooklyn"
+	if n := u.RecentBeers[0].Brewery.Name; n != breweryName {
+		t.Fatalf("unexpected RecentBeers[0].Brewery.Name: %q != %q", n, breweryName)
+	}
+
+	if l := len(u.Checkins); l != 1 {
+		t.Fatalf("unexpected Checkins length: %d != %d", l, 1)
+	}
+	checkinID := 137117722
+	if id := u.Checkins[0].ID; id != checkinID {
+		t.Fatalf("unexpected Checkins[0].ID: %d != %d", id, checkinID)
+	}
+	if n := u.Checkins[0].Beer.Name; n != beerName {
+		t.Fatalf("unexpected Checkins[0].Beer.Name: %q != %q", n, beerName)
+	}
+
+	if l := len(u.Media); l != 1 {
+		t.Fatalf("unexpected Media length: %d != %d", l, 1)
+	}
+	photoID := 24739915
+	if id := u.Media[0].ID; id != photoID {
+		t.Fatalf("unexpected Media[0].ID: %d != %d", id, photoID)
+	}
+	mediaBeerName := "Holiday Ale"
+	if n := u.Media[0].Beer.Name; n != mediaBeerName {
+		t.Fatalf("unexpected Media[0].Beer.Name: %q != %q", n, mediaBeerName)
+	}
+
+	if l := len(u.Badges); l != 1 {
+		t.Fatalf("unexpected Badges length: %d != %d", l, 1)
+	}
+	badgeName := "Taste the Music"
+	if n := u.Badges[0].Name; n != badgeName {
+		t.Fatalf("unexpected Badges[0].Name: %q != %q", n, badgeName)
+	}
 }
 
 // userInfoTestClient builds upon testClient, and adds additional sanity checks
@@ -100,6 +159,8 @@ func userInfoTestClient(t *testing.T, fn func(t *testing.T, w http.ResponseWrite
 }
 
 // Canned user JSON response, taken from documentation: https://untappd.com/api/docs#userinfo
+// Slight modifications made to wrap recent_brews and media items in arrays, as
+// returned by the API, and to add checkins and badges lists
 var gregavolaUserJSON = []byte(`
 {
   "meta": {
@@ -140,7 +201,7 @@ var gregavolaUserJSON = []byte(`
     },
     "recent_brews": {
       "count": 1,
-      "items": {
+      "items": [{
         "beer": {
           "bid": 7481,
           "beer_name": "Brooklyn Bowl Pale Ale",
@@ -171,11 +232,11 @@ var gregavolaUserJSON = []byte(`
           },
           "brewery_active": 1
         }
-      }
+      }]
     },
     "media": {
       "count": 1,
-      "items": {
+      "items": [{
         "photo_id": 24739915,
         "photo": {
           "photo_img_sm": "https://d1c8v1qci5en44.cloudfront.net/photo/2014_11_28/0a418014e88d2bc841b0f4f688714ce7_100x100.jpg",
@@ -227,7 +288,44 @@ var gregavolaUserJSON = []byte(`
           "brewery_active": 1
         },
         "venue": []
-      }
+      }]
+    },
+    "checkins": {
+      "count": 1,
+      "items": [
+        {
+          "checkin_id": 137117722,
+          "created_at": "Sat, 13 Dec 2014 19:15:38 +0000",
+          "checkin_comment": "When in Rome..",
+          "rating_score": 3,
+          "user": {
+            "uid": 1,
+            "user_name": "gregavola"
+          },
+          "beer": {
+            "bid": 7481,
+            "beer_name": "Brooklyn Bowl Pale Ale"
+          },
+          "brewery": {
+            "brewery_id": 1954,
+            "brewery_name": "Kelso of Brooklyn"
+          },
+          "venue": []
+        }
+      ]
+    },
+    "badges": {
+      "count": 1,
+      "items": [
+        {
+          "badge_id": 189,
+          "checkin_id": 137117722,
+          "badge_name": "Taste the Music",
+          "badge_description": "Badge Description Here",
+          "created_at": "Sat, 13 Dec 2014 19:15:41 +0000",
+          "levels": []
+        }
+      ]
     },
     "contact": {
       "foursquare": 195741,
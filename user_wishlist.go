@@ -1,6 +1,9 @@
 package untappd
 
 import (
+	"context"
+	"encoding/json"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -14,8 +17,13 @@ import (
 // For more granular control, and to page through and sort the beers list, use
 // WishListOffsetLimitSort instead.
 func (u *UserService) WishList(username string) ([]*Beer, *http.Response, error) {
+	return u.WishListContext(context.Background(), username)
+}
+
+// WishListContext is like WishList, but uses ctx for the request.
+func (u *UserService) WishListContext(ctx context.Context, username string) ([]*Beer, *http.Response, error) {
 	// Use default parameters as specified by API
-	return u.WishListOffsetLimitSort(username, 0, 25, SortDate)
+	return u.WishListOffsetLimitSortContext(ctx, username, 0, 25, SortDate)
 }
 
 // WishListOffsetLimitSort queries for information about a User's wish list beers,
@@ -26,6 +34,19 @@ func (u *UserService) WishList(username string) ([]*Beer, *http.Response, error)
 //
 // 50 beers is the maximum number of beers which may be returned by one call.
 func (u *UserService) WishListOffsetLimitSort(username string, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error) {
+	return u.WishListOffsetLimitSortContext(context.Background(), username, offset, limit, sort)
+}
+
+// WishListOffsetLimitSortContext is like WishListOffsetLimitSort, but uses ctx
+// for the request.
+func (u *UserService) WishListOffsetLimitSortContext(ctx context.Context, username string, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error) {
+	return traceCall(ctx, u.client, "untappd.User.WishList", func(ctx context.Context) ([]*Beer, *http.Response, error) {
+		return u.wishListOffsetLimitSort(ctx, username, offset, limit, sort)
+	})
+}
+
+// wishListOffsetLimitSort implements WishListOffsetLimitSortContext.
+func (u *UserService) wishListOffsetLimitSort(ctx context.Context, username string, offset int, limit int, sort Sort) ([]*Beer, *http.Response, error) {
 	q := url.Values{
 		"offset": []string{strconv.Itoa(offset)},
 		"limit":  []string{strconv.Itoa(limit)},
@@ -36,34 +57,58 @@ func (u *UserService) WishListOffsetLimitSort(username string, offset int, limit
 	var v struct {
 		Response struct {
 			Beers struct {
-				Count int `json:"count"`
-				Items []struct {
-					WishListed responseTime `json:"created_at"`
-					Beer       rawBeer      `json:"beer"`
-					Brewery    rawBrewery   `json:"brewery"`
-				} `json:"items"`
+				Count int               `json:"count"`
+				Items []json.RawMessage `json:"items"`
 			} `json:"beers"`
 		} `json:"response"`
 	}
 
 	// Perform request for user beers by username
-	res, err := u.client.request("GET", "user/wishlist/"+username, nil, q, &v)
+	endpoint := "user/wishlist/" + username
+	res, err := u.client.requestContext(ctx, "GET", endpoint, nil, q, &v)
 	if err != nil {
 		return nil, res, err
 	}
 
 	// Build result slice from struct
-	beers := make([]*Beer, v.Response.Beers.Count)
-	for i := range v.Response.Beers.Items {
+	var beers []*Beer
+	err = u.client.decodeItems(endpoint, "response.beers.items", v.Response.Beers.Count, v.Response.Beers.Items, func(decode func(v interface{}) error) error {
+		var item struct {
+			WishListed responseTime `json:"created_at"`
+			Beer       rawBeer      `json:"beer"`
+			Brewery    rawBrewery   `json:"brewery"`
+		}
+		if err := decode(&item); err != nil {
+			return err
+		}
+
 		// Information about the beer itself
-		beers[i] = v.Response.Beers.Items[i].Beer.export()
+		beer := item.Beer.export()
 
 		// Information about the beer's brewery
-		beers[i].Brewery = v.Response.Beers.Items[i].Brewery.export()
+		beer.Brewery = item.Brewery.export()
 
 		// Information related to this user and this beer
-		beers[i].WishListed = time.Time(v.Response.Beers.Items[i].WishListed)
-	}
+		beer.WishListed = time.Time(item.WishListed)
+
+		beers = append(beers, beer)
+		return nil
+	})
 
-	return beers, res, nil
+	return beers, res, err
+}
+
+// AllWishList returns an iterator over all beers on a User's wish list,
+// paging through the wish list using WishListOffsetLimitSort.  The username
+// parameter specifies the User whose wish list will be returned, and beers
+// may be sorted using any of the provided Sort constants with this package.
+//
+// One API call is made for every 50 beers, and no further calls are made
+// once iteration stops.  Iteration ends after yielding a request error, or if
+// ctx is canceled.
+func (u *UserService) AllWishList(ctx context.Context, username string, sort Sort) iter.Seq2[*Beer, error] {
+	return offsetPages(ctx, u.client, "untappd.User.AllWishList", 50, func(ctx context.Context, offset int, limit int) ([]*Beer, error) {
+		beers, _, err := u.WishListOffsetLimitSortContext(ctx, username, offset, limit, sort)
+		return beers, err
+	})
 }
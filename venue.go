@@ -1,6 +1,8 @@
 package untappd
 
 import (
+	"encoding/json"
+	"strings"
 	"time"
 )
 
@@ -15,20 +17,56 @@ type Venue struct {
 	// Category of thie venue.
 	Category string
 
+	// All categories this venue belongs to, including its primary category.
+	Categories []*VenueCategory
+
 	// Is this a public venue?
 	Public bool
 
+	// Has this venue been verified by its owner?
+	Verified bool
+
 	// Location of this venue.
 	Location VenueLocation
 
+	// Contact information for this venue.
+	Contact VenueContact
+
+	// If available, struct containing this venue's total checkins, unique
+	// users, and other various totals.
+	Stats VenueStats
+
 	// Foursquare data.
 	Foursquare VenueFoursquare
 
+	// If available, the hours during which this venue is open on each
+	// day of the week.
+	Hours []*VenueHours
+
+	// If this venue has been verified, the beer menus published by its
+	// owner.
+	Menus []*VenueMenu
+
 	// Popular beers at this venue.
 	TopBeers []*Beer
 
 	// Checkins at this venue.
 	Checkins []*Checkin
+
+	// If available, photos from recent checkins at this venue.
+	Media []*Photo
+
+	// The number of categories, popular beers, checkins, and photos
+	// reported by the API, which may differ from the length of each list.
+	CategoryCount int
+	TopBeerCount  int
+	CheckinCount  int
+	MediaCount    int
+	MenuCount     int
+
+	// If Client.RawJSON is enabled, the original JSON representation of
+	// this venue, including any fields which are not otherwise exported.
+	Raw json.RawMessage `json:",omitempty"`
 }
 
 // VenueService is a "service" which allows access to API methods involving
@@ -49,6 +87,33 @@ type VenueLocation struct {
 	Longitude float64 `json:"lng"`
 }
 
+// VenueCategory represents a Foursquare category for an Untappd venue, such
+// as "Bar" or "Brewery".
+type VenueCategory struct {
+	ID      string `json:"category_id"`
+	Name    string `json:"category_name"`
+	Primary bool   `json:"is_primary"`
+}
+
+// VenueContact represents an Untappd venue's contact social media, website,
+// and telephone information.
+type VenueContact struct {
+	Twitter  string `json:"twitter"`
+	Facebook string `json:"facebook"`
+	URL      string `json:"venue_url"`
+	Phone    string `json:"phone"`
+}
+
+// VenueStats is a struct which contains various statistics regarding an
+// Untappd venue.
+type VenueStats struct {
+	TotalCount     int `json:"total_count"`
+	UserCount      int `json:"user_count"`
+	TotalUserCount int `json:"total_user_count"`
+	MonthlyCount   int `json:"monthly_count"`
+	WeeklyCount    int `json:"weekly_count"`
+}
+
 // VenueFoursquare represents an Untappd venue's Foursquare data, and contains
 // the venue's Foursquare ID and URL.
 type VenueFoursquare struct {
@@ -56,6 +121,119 @@ type VenueFoursquare struct {
 	URL string `json:"foursquare_url"`
 }
 
+// VenueHours represents the hours during which an Untappd venue is open on
+// a single day of the week.  Open and Close are local times at the venue, in
+// 24-hour "15:04" format.
+type VenueHours struct {
+	Day    time.Weekday
+	Open   string
+	Close  string
+	Closed bool
+}
+
+// VenueMenu represents a beer menu published by the owner of a verified
+// Untappd venue.  Each menu is divided into sections, such as "On Tap" or
+// "Bottles".
+type VenueMenu struct {
+	ID          int
+	Name        string
+	Description string
+	Updated     time.Time
+	Sections    []*VenueMenuSection
+}
+
+// VenueMenuSection represents a section of a VenueMenu, and contains the
+// beers listed in that section.
+type VenueMenuSection struct {
+	ID          int
+	Name        string
+	Description string
+	Beers       []*Beer
+}
+
+// rawVenueHours is the raw JSON representation of a VenueHours struct.
+type rawVenueHours struct {
+	Day    string       `json:"day_of_week"`
+	Open   string       `json:"open_time"`
+	Close  string       `json:"close_time"`
+	Closed responseBool `json:"is_closed"`
+}
+
+// export creates an exported VenueHours from a rawVenueHours struct.  If the
+// day of the week is not recognized, ok is false.
+func (r *rawVenueHours) export() (h *VenueHours, ok bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if !strings.EqualFold(r.Day, d.String()) {
+			continue
+		}
+
+		return &VenueHours{
+			Day:    d,
+			Open:   r.Open,
+			Close:  r.Close,
+			Closed: bool(r.Closed),
+		}, true
+	}
+
+	return nil, false
+}
+
+// rawVenueMenu is the raw JSON representation of a VenueMenu.
+type rawVenueMenu struct {
+	ID          int          `json:"menu_id"`
+	Name        string       `json:"menu_name"`
+	Description string       `json:"menu_description"`
+	Updated     responseTime `json:"updated_at"`
+	Sections    struct {
+		Count int `json:"count"`
+		Items []*struct {
+			ID          int    `json:"section_id"`
+			Name        string `json:"section_name"`
+			Description string `json:"section_description"`
+			Beers       struct {
+				Count int `json:"count"`
+				Items []struct {
+					Beer    rawBeer    `json:"beer"`
+					Brewery rawBrewery `json:"brewery"`
+				} `json:"items"`
+			} `json:"beers"`
+		} `json:"items"`
+	} `json:"sections"`
+}
+
+// export creates an exported VenueMenu from a rawVenueMenu struct.
+func (r *rawVenueMenu) export() *VenueMenu {
+	sections := make([]*VenueMenuSection, 0, len(r.Sections.Items))
+	for _, item := range r.Sections.Items {
+		if item == nil {
+			continue
+		}
+
+		beers := make([]*Beer, 0, len(item.Beers.Items))
+		for _, b := range item.Beers.Items {
+			beer := b.Beer.export()
+			beer.Brewery = b.Brewery.export()
+
+			beers = append(beers, beer)
+		}
+
+		sections = append(sections, &VenueMenuSection{
+			ID:          item.ID,
+			Name:        item.Name,
+			Description: item.Description,
+			Beers:       beers,
+		})
+	}
+
+	return &VenueMenu{
+		ID:          r.ID,
+		Name:        r.Name,
+		Description: r.Description,
+		Updated:     time.Time(r.Updated),
+		Sections:    sections,
+	}
+}
+
 // rawVenue is the raw JSON representation of an Untappd venue.  Its data is
 // unmarshaled from JSON and then exported to a Venue struct.
 type rawVenue struct {
@@ -64,9 +242,16 @@ type rawVenue struct {
 	Updated    responseTime    `json:"last_updated"`
 	Category   string          `json:"primary_category"`
 	Public     bool            `json:"public_venue"`
+	Verified   bool            `json:"is_verified"`
 	Location   VenueLocation   `json:"location"`
+	Contact    VenueContact    `json:"contact"`
+	Stats      VenueStats      `json:"stats"`
 	Foursquare VenueFoursquare `json:"foursquare"`
-	TopBeers   struct {
+	Categories struct {
+		Count int              `json:"count"`
+		Items []*VenueCategory `json:"items"`
+	} `json:"categories"`
+	TopBeers struct {
 		Offset int `json:"offset"`
 		Limit  int `json:"limit"`
 		Count  int `json:"count"`
@@ -83,20 +268,78 @@ type rawVenue struct {
 		Count int           `json:"count"`
 		Items []*rawCheckin `json:"items"`
 	} `json:"checkins"`
+	Media struct {
+		Count int         `json:"count"`
+		Items []*rawPhoto `json:"items"`
+	} `json:"media"`
+	Hours struct {
+		Count int              `json:"count"`
+		Items []*rawVenueHours `json:"items"`
+	} `json:"hours"`
+	Menus struct {
+		Count int             `json:"count"`
+		Items []*rawVenueMenu `json:"items"`
+	} `json:"menus"`
+
+	Raw json.RawMessage `json:"-"`
 }
 
 // export creates an exported Venue from a rawVenue struct, allowing for
 // more useful structures to be created for client consumption.
 func (r *rawVenue) export() *Venue {
-	beers := make([]*Beer, r.TopBeers.Count)
-	for i := range r.TopBeers.Items {
-		beers[i] = r.TopBeers.Items[i].Beer.export()
-		beers[i].Brewery = r.TopBeers.Items[i].Brewery.export()
+	beers := make([]*Beer, 0, len(r.TopBeers.Items))
+	for _, item := range r.TopBeers.Items {
+		beer := item.Beer.export()
+		beer.Brewery = item.Brewery.export()
+
+		beers = append(beers, beer)
+	}
+
+	checkins := make([]*Checkin, 0, len(r.Checkins.Items))
+	for _, item := range r.Checkins.Items {
+		if item == nil {
+			continue
+		}
+
+		checkins = append(checkins, item.export())
+	}
+
+	categories := make([]*VenueCategory, 0, len(r.Categories.Items))
+	for _, item := range r.Categories.Items {
+		if item == nil {
+			continue
+		}
+
+		categories = append(categories, item)
+	}
+
+	media := make([]*Photo, 0, len(r.Media.Items))
+	for _, item := range r.Media.Items {
+		if item == nil {
+			continue
+		}
+
+		media = append(media, item.export())
 	}
 
-	checkins := make([]*Checkin, r.Checkins.Count)
-	for i := range r.Checkins.Items {
-		checkins[i] = r.Checkins.Items[i].export()
+	hours := make([]*VenueHours, 0, len(r.Hours.Items))
+	for _, item := range r.Hours.Items {
+		if item == nil {
+			continue
+		}
+
+		if h, ok := item.export(); ok {
+			hours = append(hours, h)
+		}
+	}
+
+	menus := make([]*VenueMenu, 0, len(r.Menus.Items))
+	for _, item := range r.Menus.Items {
+		if item == nil {
+			continue
+		}
+
+		menus = append(menus, item.export())
 	}
 
 	return &Venue{
@@ -104,10 +347,25 @@ func (r *rawVenue) export() *Venue {
 		Name:       r.Name,
 		Updated:    time.Time(r.Updated),
 		Category:   r.Category,
+		Categories: categories,
 		Public:     r.Public,
+		Verified:   r.Verified,
 		Location:   r.Location,
+		Contact:    r.Contact,
+		Stats:      r.Stats,
 		Foursquare: r.Foursquare,
+		Hours:      hours,
+		Menus:      menus,
 		TopBeers:   beers,
 		Checkins:   checkins,
+		Media:      media,
+
+		CategoryCount: r.Categories.Count,
+		TopBeerCount:  r.TopBeers.Count,
+		CheckinCount:  r.Checkins.Count,
+		MediaCount:    r.Media.Count,
+		MenuCount:     r.Menus.Count,
+
+		Raw: r.Raw,
 	}
 }
@@ -1,6 +1,7 @@
 package untappd
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -10,6 +11,18 @@ import (
 // If the compact parameter is set to 'true', only basic venue information will
 // be populated.
 func (b *VenueService) Info(id int, compact bool) (*Venue, *http.Response, error) {
+	return b.InfoContext(context.Background(), id, compact)
+}
+
+// InfoContext is like Info, but uses ctx for the request.
+func (b *VenueService) InfoContext(ctx context.Context, id int, compact bool) (*Venue, *http.Response, error) {
+	return traceCall(ctx, b.client, "untappd.Venue.Info", func(ctx context.Context) (*Venue, *http.Response, error) {
+		return b.info(ctx, id, compact)
+	})
+}
+
+// info implements InfoContext.
+func (b *VenueService) info(ctx context.Context, id int, compact bool) (*Venue, *http.Response, error) {
 	// Determine if a compact response is requested
 	q := url.Values{}
 	if compact {
@@ -24,10 +37,25 @@ func (b *VenueService) Info(id int, compact bool) (*Venue, *http.Response, error
 	}
 
 	// Perform request for venue information by ID
-	res, err := b.client.request("GET", "venue/info/"+strconv.Itoa(id), nil, q, &v)
+	res, err := b.client.requestContext(ctx, "GET", "venue/info/"+strconv.Itoa(id), nil, q, &v)
 	if err != nil {
 		return nil, res, err
 	}
 
 	return v.Response.Venue.export(), res, nil
 }
+
+// InfoMany queries for information about each Venue with the specified IDs,
+// using up to Client.BatchConcurrency concurrent requests.  The returned
+// slice contains a Venue for each of ids, in the same order.
+//
+// If information about any Venue could not be retrieved, InfoMany returns
+// ItemErrors, and the Venue at the index of each failure is nil.  Once ctx
+// is canceled or the rate limit is exhausted, ErrRateLimitExhausted or
+// the context's error is returned for each remaining Venue.
+func (b *VenueService) InfoMany(ctx context.Context, ids []int, compact bool) ([]*Venue, error) {
+	return batch(ctx, b.client, "untappd.Venue.InfoMany", ids, func(ctx context.Context, id int) (*Venue, error) {
+		venue, _, err := b.InfoContext(ctx, id, compact)
+		return venue, err
+	})
+}
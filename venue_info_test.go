@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 )
 
 // TestClientVenueInfoBadVenue verifies that Client.Venue.Info returns an error when
@@ -104,6 +105,100 @@ func TestClientVenueInfoOK(t *testing.T) {
 	if c := v.Checkins[0].Brewery.Name; c != beerBrewery {
 		t.Fatalf("unexpected Checkins[0].Brewery.Name: %q != %q", c, beerBrewery)
 	}
+	if !v.Public {
+		t.Fatal("expected Public to be true")
+	}
+	if !v.Verified {
+		t.Fatal("expected Verified to be true")
+	}
+
+	categories := []VenueCategory{
+		{ID: "50327c8591d4c4b30a586d5d", Name: "Brewery", Primary: true},
+		{ID: "4bf58dd8d48988d116941735", Name: "Bar", Primary: false},
+	}
+	if l := len(v.Categories); l != len(categories) {
+		t.Fatalf("unexpected Categories length: %d != %d", l, len(categories))
+	}
+	for i := range categories {
+		if c := *v.Categories[i]; c != categories[i] {
+			t.Fatalf("unexpected Categories[%d]: %+v != %+v", i, c, categories[i])
+		}
+	}
+
+	contact := VenueContact{
+		Twitter: "BellsEccentric",
+		URL:     "http://www.bellsbeer.com/eccentric-cafe",
+		Phone:   "269-382-2332",
+	}
+	if c := v.Contact; c != contact {
+		t.Fatalf("unexpected Contact: %+v != %+v", c, contact)
+	}
+
+	stats := VenueStats{
+		TotalCount:     275312,
+		UserCount:      3,
+		TotalUserCount: 89321,
+		MonthlyCount:   4182,
+		WeeklyCount:    986,
+	}
+	if s := v.Stats; s != stats {
+		t.Fatalf("unexpected Stats: %+v != %+v", s, stats)
+	}
+
+	hours := []VenueHours{
+		{Day: time.Monday, Open: "11:00", Close: "00:00"},
+		{Day: time.Sunday, Closed: true},
+	}
+	if l := len(v.Hours); l != len(hours) {
+		t.Fatalf("unexpected Hours length: %d != %d", l, len(hours))
+	}
+	for i := range hours {
+		if h := *v.Hours[i]; h != hours[i] {
+			t.Fatalf("unexpected Hours[%d]: %+v != %+v", i, h, hours[i])
+		}
+	}
+
+	if l := len(v.Menus); l != 1 {
+		t.Fatalf("unexpected Menus length: %d != %d", l, 1)
+	}
+	if c := v.MenuCount; c != 1 {
+		t.Fatalf("unexpected MenuCount: %d != %d", c, 1)
+	}
+	menu := v.Menus[0]
+	if menu.ID != 42 || menu.Name != "Draft List" {
+		t.Fatalf("unexpected Menus[0]: %d, %q", menu.ID, menu.Name)
+	}
+	updated := time.Date(2016, time.May, 20, 18, 0, 0, 0, time.UTC)
+	if u := menu.Updated; !u.Equal(updated) {
+		t.Fatalf("unexpected Menus[0].Updated: %v != %v", u, updated)
+	}
+	if l := len(menu.Sections); l != 1 {
+		t.Fatalf("unexpected Menus[0].Sections length: %d != %d", l, 1)
+	}
+	section := menu.Sections[0]
+	if n := section.Name; n != "On Tap" {
+		t.Fatalf("unexpected Menus[0].Sections[0].Name: %q != %q", n, "On Tap")
+	}
+	if l := len(section.Beers); l != 1 {
+		t.Fatalf("unexpected Menus[0].Sections[0].Beers length: %d != %d", l, 1)
+	}
+	if n := section.Beers[0].Name; n != "Black Note Stout" {
+		t.Fatalf("unexpected Menus[0].Sections[0].Beers[0].Name: %q != %q", n, "Black Note Stout")
+	}
+	if n := section.Beers[0].Brewery.Name; n != "Bell's Brewery, Inc." {
+		t.Fatalf("unexpected Menus[0].Sections[0].Beers[0].Brewery.Name: %q != %q", n, "Bell's Brewery, Inc.")
+	}
+
+	if l := len(v.Media); l != 1 {
+		t.Fatalf("unexpected Media length: %d != %d", l, 1)
+	}
+	photoID := 24739917
+	if id := v.Media[0].ID; id != photoID {
+		t.Fatalf("unexpected Media[0].ID: %d != %d", id, photoID)
+	}
+	if id := v.Media[0].Venue.ID; id != venueID {
+		t.Fatalf("unexpected Media[0].Venue.ID: %d != %d", id, venueID)
+	}
 }
 
 // venueInfoTestClient builds upon testClient, and adds additional sanity checks
@@ -144,13 +239,123 @@ var venueJSON = []byte(`
     "venue": {
       "venue_id": 1021,
       "venue_name": "Bell's Eccentric Cafe & General Store",
+      "primary_category": "Nightlife Spot",
+      "categories": {
+        "count": 2,
+        "items": [
+          {
+            "category_name": "Brewery",
+            "category_id": "50327c8591d4c4b30a586d5d",
+            "is_primary": true
+          },
+          {
+            "category_name": "Bar",
+            "category_id": "4bf58dd8d48988d116941735",
+            "is_primary": false
+          }
+        ]
+      },
+      "stats": {
+        "total_count": 275312,
+        "user_count": 3,
+        "total_user_count": 89321,
+        "monthly_count": 4182,
+        "weekly_count": 986
+      },
+      "public_venue": true,
+      "is_verified": true,
       "location": {
         "venue_city": "Kalamazoo"
       },
+      "contact": {
+        "twitter": "BellsEccentric",
+        "venue_url": "http://www.bellsbeer.com/eccentric-cafe",
+        "phone": "269-382-2332"
+      },
+      "media": {
+        "count": 1,
+        "items": [
+          {
+            "photo_id": 24739917,
+            "photo": {
+              "photo_img_sm": "https://untappd.akamaized.net/photo/2016_05_21/eccentric_100x100.jpg",
+              "photo_img_md": "https://untappd.akamaized.net/photo/2016_05_21/eccentric_320x320.jpg",
+              "photo_img_lg": "https://untappd.akamaized.net/photo/2016_05_21/eccentric_640x640.jpg",
+              "photo_img_og": "https://untappd.akamaized.net/photo/2016_05_21/eccentric_raw.jpg"
+            },
+            "created_at": "Sat, 21 May 2016 00:15:40 +0000",
+            "checkin_id": 133319905,
+            "user": {
+              "user_name": "gregavola"
+            },
+            "beer": {
+              "beer_name": "Beer Name"
+            },
+            "brewery": {
+              "brewery_name": "Brewery Name"
+            },
+            "venue": {
+              "venue_id": 1021,
+              "venue_name": "Bell's Eccentric Cafe & General Store"
+            }
+          }
+        ]
+      },
       "foursquare": {
         "foursquare_id": "4a8f8efcf964a520761520e3",
         "foursquare_url": "http://4sq.com/dheQpl"
       },
+      "hours": {
+        "count": 3,
+        "items": [
+          {
+            "day_of_week": "Monday",
+            "open_time": "11:00",
+            "close_time": "00:00"
+          },
+          {
+            "day_of_week": "sunday",
+            "is_closed": 1
+          },
+          {
+            "day_of_week": "Someday"
+          }
+        ]
+      },
+      "menus": {
+        "count": 1,
+        "items": [
+          {
+            "menu_id": 42,
+            "menu_name": "Draft List",
+            "menu_description": "Beers on tap at the Eccentric Cafe",
+            "updated_at": "Fri, 20 May 2016 18:00:00 +0000",
+            "sections": {
+              "count": 1,
+              "items": [
+                {
+                  "section_id": 7,
+                  "section_name": "On Tap",
+                  "beers": {
+                    "count": 1,
+                    "items": [
+                      {
+                        "beer": {
+                          "bid": 1,
+                          "beer_name": "Black Note Stout"
+                        },
+                        "brewery": {
+                          "brewery_name": "Bell's Brewery, Inc."
+                        }
+                      }
+                    ]
+                  }
+                }
+              ]
+            }
+          }
+        ]
+      },
       "top_beers": {
         "offset": 0,
         "limit": 15,